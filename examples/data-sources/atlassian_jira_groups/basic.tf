@@ -0,0 +1,7 @@
+data "atlassian_jira_groups" "example" {
+  name_regex = "^jira-"
+}
+
+output "group_names" {
+  value = data.atlassian_jira_groups.example.groups[*].name
+}
@@ -0,0 +1,3 @@
+data "atlassian_jira_issue_field_configuration_schemes" "example" {
+  ids = ["10000", "10001"]
+}
@@ -0,0 +1,3 @@
+data "atlassian_jira_issue_field_configurations" "example" {
+  name_regex = "Configuration$"
+}
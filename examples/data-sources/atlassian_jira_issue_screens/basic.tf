@@ -0,0 +1,3 @@
+data "atlassian_jira_issue_screens" "example" {
+  name_regex = "^Default"
+}
@@ -0,0 +1,3 @@
+data "atlassian_jira_issue_type_schemes" "example" {
+  name_regex = "Issue Type Scheme$"
+}
@@ -0,0 +1,3 @@
+data "atlassian_jira_issue_type_screen_schemes" "example" {
+  ids = ["1"] // id of default issue type screen scheme
+}
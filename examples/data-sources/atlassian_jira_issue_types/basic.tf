@@ -0,0 +1,9 @@
+data "atlassian_jira_issue_types" "example" {
+  name_regex = "^(Bug|Task|Story)$"
+}
+
+resource "atlassian_jira_issue_type_scheme" "example" {
+  name                  = "Example Issue Type Scheme"
+  issue_type_ids        = data.atlassian_jira_issue_types.example.issue_types[*].id
+  default_issue_type_id = data.atlassian_jira_issue_types.example.issue_types[0].id
+}
@@ -0,0 +1,7 @@
+data "atlassian_jira_permission_schemes" "example" {
+  name_regex = "Permission Scheme$"
+}
+
+output "permission_scheme_ids" {
+  value = { for s in data.atlassian_jira_permission_schemes.example.permission_schemes : s.name => s.id }
+}
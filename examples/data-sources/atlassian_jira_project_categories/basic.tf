@@ -0,0 +1 @@
+data "atlassian_jira_project_categories" "example" {}
@@ -0,0 +1,3 @@
+data "atlassian_jira_screen_schemes" "example" {
+  name_regex = "^Default"
+}
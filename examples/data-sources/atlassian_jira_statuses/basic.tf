@@ -0,0 +1,3 @@
+data "atlassian_jira_statuses" "example" {
+  name_regex = "(?i)^(to do|in progress|done)$"
+}
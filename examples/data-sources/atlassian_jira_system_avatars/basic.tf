@@ -0,0 +1,3 @@
+data "atlassian_jira_system_avatars" "example" {
+  type = "issuetype"
+}
@@ -0,0 +1,22 @@
+provider "atlassian" {
+  url      = "https://foo-bar.atlassian.net"
+  username = "foo@bar.com"
+  apitoken = var.apitoken
+
+  sites = {
+    staging = {
+      url      = "https://foo-bar-staging.atlassian.net"
+      username = "foo@bar.com"
+      apitoken = var.staging_apitoken
+    }
+  }
+}
+
+resource "atlassian_jira_project_category" "production" {
+  name = "foo"
+}
+
+resource "atlassian_jira_project_category" "staging" {
+  site = "staging"
+  name = "foo"
+}
@@ -0,0 +1,8 @@
+provider "atlassian" {
+  url          = "https://jira.example.com"
+  proxy_url    = "http://proxy.example.com:3128"
+  ca_cert_file = "/etc/ssl/certs/example-ca.pem"
+
+  client_cert_pem = file("client.pem")
+  client_key_pem  = file("client-key.pem")
+}
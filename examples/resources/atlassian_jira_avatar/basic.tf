@@ -0,0 +1,10 @@
+resource "atlassian_jira_avatar" "example" {
+  type     = "issuetype"
+  owner_id = "10000"
+  source   = "${path.module}/avatar.png"
+}
+
+resource "atlassian_jira_issue_type" "example" {
+  name      = "My Issue Type"
+  avatar_id = tonumber(atlassian_jira_avatar.example.id)
+}
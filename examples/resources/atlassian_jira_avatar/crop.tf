@@ -0,0 +1,8 @@
+resource "atlassian_jira_avatar" "example" {
+  type      = "project"
+  owner_id  = "10000"
+  source    = "${path.module}/logo.svg"
+  crop_x    = 10
+  crop_y    = 10
+  crop_size = 128
+}
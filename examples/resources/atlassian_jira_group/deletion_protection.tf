@@ -0,0 +1,5 @@
+resource "atlassian_jira_group" "example" {
+  name = "foo"
+
+  deletion_protection = true
+}
@@ -0,0 +1,2 @@
+# The import identifier can be prefixed with the name of a site configured in the provider, e.g. staging:jira-administrators
+terraform import atlassian_jira_group.example jira-administrators
@@ -0,0 +1,4 @@
+import {
+  to = atlassian_jira_group.example
+  id = "jira-administrators"
+}
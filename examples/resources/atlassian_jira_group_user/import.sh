@@ -0,0 +1,2 @@
+# The import identifier can be prefixed with the name of a site configured in the provider, e.g. staging:jira-administrators,5b10ac8d82e05b22cc7d4ef5
+terraform import atlassian_jira_group_user.example jira-administrators,5b10ac8d82e05b22cc7d4ef5
@@ -0,0 +1,4 @@
+import {
+  to = atlassian_jira_group_user.example
+  id = "jira-administrators,5b10ac8d82e05b22cc7d4ef5"
+}
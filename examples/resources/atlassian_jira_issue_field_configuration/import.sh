@@ -0,0 +1,2 @@
+# The import identifier can be prefixed with the name of a site configured in the provider, e.g. staging:10000
+terraform import atlassian_jira_issue_field_configuration.example 10000
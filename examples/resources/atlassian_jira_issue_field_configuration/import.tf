@@ -0,0 +1,4 @@
+import {
+  to = atlassian_jira_issue_field_configuration.example
+  id = "10000"
+}
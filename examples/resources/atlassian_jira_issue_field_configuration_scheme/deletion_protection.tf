@@ -0,0 +1,5 @@
+resource "atlassian_jira_issue_field_configuration_scheme" "example" {
+  name = "foo"
+
+  deletion_protection = true
+}
@@ -0,0 +1,8 @@
+resource "atlassian_jira_issue_field_configuration_scheme" "example" {
+  name = "foo"
+}
+
+resource "atlassian_jira_issue_field_configuration_scheme_association" "example" {
+  project_id                    = "10000"
+  field_configuration_scheme_id = atlassian_jira_issue_field_configuration_scheme.example.id
+}
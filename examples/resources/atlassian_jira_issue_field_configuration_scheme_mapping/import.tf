@@ -0,0 +1,4 @@
+import {
+  to = atlassian_jira_issue_field_configuration_scheme_mapping.example
+  id = "10000,10001,10100"
+}
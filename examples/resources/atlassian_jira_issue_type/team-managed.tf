@@ -0,0 +1,7 @@
+resource "atlassian_jira_issue_type" "issue_type" {
+  name = "My Issue Type"
+  scope = {
+    type       = "PROJECT"
+    project_id = "10000"
+  }
+}
@@ -0,0 +1,9 @@
+resource "atlassian_jira_issue_type_scheme" "example" {
+  name           = "foo"
+  issue_type_ids = ["10001"]
+}
+
+resource "atlassian_jira_issue_type_scheme_association" "example" {
+  project_id           = "10000"
+  issue_type_scheme_id = atlassian_jira_issue_type_scheme.example.id
+}
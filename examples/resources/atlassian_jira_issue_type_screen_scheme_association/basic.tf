@@ -0,0 +1,14 @@
+resource "atlassian_jira_issue_type_screen_scheme" "example" {
+  name = "foo"
+  issue_type_mappings = [
+    {
+      issue_type_id    = "default"
+      screen_scheme_id = "1"
+    },
+  ]
+}
+
+resource "atlassian_jira_issue_type_screen_scheme_association" "example" {
+  project_id                  = "10000"
+  issue_type_screen_scheme_id = atlassian_jira_issue_type_screen_scheme.example.id
+}
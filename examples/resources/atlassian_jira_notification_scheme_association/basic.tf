@@ -0,0 +1,4 @@
+resource "atlassian_jira_notification_scheme_association" "example" {
+  project_id             = "10000"
+  notification_scheme_id = "10100"
+}
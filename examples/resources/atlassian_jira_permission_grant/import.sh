@@ -0,0 +1,2 @@
+# The import identifier can be prefixed with the name of a site configured in the provider, e.g. staging:10100,10000
+terraform import atlassian_jira_permission_grant.example 10100,10000
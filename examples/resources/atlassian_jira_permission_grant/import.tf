@@ -0,0 +1,4 @@
+import {
+  to = atlassian_jira_permission_grant.example
+  id = "10100,10000"
+}
@@ -0,0 +1,5 @@
+resource "atlassian_jira_permission_scheme" "example" {
+  name = "foo"
+
+  deletion_protection = true
+}
@@ -0,0 +1,18 @@
+resource "atlassian_jira_permission_scheme" "example" {
+  name = "foo"
+  permissions = [
+    {
+      holder = {
+        type = "anyone"
+      }
+      permission = "BROWSE_PROJECTS"
+    },
+    {
+      holder = {
+        type      = "group"
+        parameter = "site-admins"
+      }
+      permission = "ADMINISTER_PROJECTS"
+    },
+  ]
+}
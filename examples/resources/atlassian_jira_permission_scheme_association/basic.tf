@@ -0,0 +1,8 @@
+resource "atlassian_jira_permission_scheme" "example" {
+  name = "foo"
+}
+
+resource "atlassian_jira_permission_scheme_association" "example" {
+  project_id           = "10000"
+  permission_scheme_id = atlassian_jira_permission_scheme.example.id
+}
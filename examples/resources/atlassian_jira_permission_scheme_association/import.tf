@@ -0,0 +1,4 @@
+import {
+  to = atlassian_jira_permission_scheme_association.example
+  id = "10000,10100"
+}
@@ -0,0 +1,4 @@
+import {
+  to = atlassian_jira_project_category.example
+  id = "10000"
+}
@@ -0,0 +1,4 @@
+import {
+  to = atlassian_jira_status.example
+  id = "10000"
+}
@@ -0,0 +1,4 @@
+resource "atlassian_jira_workflow_scheme_association" "example" {
+  project_id         = "10000"
+  workflow_scheme_id = "10100"
+}
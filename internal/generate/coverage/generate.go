@@ -0,0 +1,3 @@
+package coverage
+
+//go:generate go run main.go
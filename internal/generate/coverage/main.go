@@ -0,0 +1,200 @@
+//go:build ignore
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/openscientia/terraform-provider-atlassian/internal/generate/labels"
+	atlassian "github.com/openscientia/terraform-provider-atlassian/internal/provider"
+)
+
+const (
+	filename = `../../../templates/guides/jira-api-coverage.md.tmpl`
+
+	providerTypeName = "atlassian"
+	product          = "jira"
+)
+
+// Row is the coverage of a tag of the OpenAPI spec, or of the resources and data sources without
+// a tag when Tag is empty.
+type Row struct {
+	Tag         string
+	Resources   []string
+	DataSources []string
+	// Importable are the resources supporting terraform import.
+	Importable []string
+}
+
+// Import returns the import support of the resources of the row.
+func (r Row) Import() string {
+	switch {
+	case len(r.Resources) == 0:
+		return ""
+	case len(r.Importable) == len(r.Resources):
+		return "Yes"
+	case len(r.Importable) == 0:
+		return "No"
+	}
+	return fmt.Sprintf("Partial (%d of %d)", len(r.Importable), len(r.Resources))
+}
+
+// tagAliases are the tags of the resources and data sources whose names differ from their tag, by
+// type name without the provider prefix. The resources named after the custom tags of the labels,
+// which are not tags of the OpenAPI spec, are mapped to the tag of their operations.
+var tagAliases = map[string]string{
+	"jira_group_user":                               "Groups",
+	"jira_issue_field_configuration_item":           "Issue field configurations",
+	"jira_issue_field_configuration_scheme_mapping": "Issue field configurations",
+	"jira_issue_screen":                             "Screens",
+	"jira_issue_screens":                            "Screens",
+	"jira_notification_scheme_association":          "Issue notification schemes",
+	"jira_permission_grant":                         "Permission schemes",
+	"jira_system_avatars":                           "Avatars",
+}
+
+type templateData struct {
+	Rows []Row
+	// Other is the row of the resources and data sources without a tag.
+	Other   Row
+	Covered int
+}
+
+func main() {
+	fmt.Printf("Generating %s\n", strings.TrimPrefix(filename, "../../../"))
+
+	allTags, err := labels.SpecTags(labels.SpecsDir, false)
+	if err != nil {
+		log.Fatalf("error reading tags: %s", err)
+	}
+
+	td := templateData{}
+	rows := map[string]*Row{}
+	var tags []labels.Tag
+	for _, t := range allTags {
+		if t.Product != product {
+			continue
+		}
+		tags = append(tags, t)
+		rows[t.Name] = &Row{Tag: t.Name}
+	}
+	row := func(typeName string) *Row {
+		name := strings.TrimPrefix(typeName, providerTypeName+"_")
+		if r, ok := rows[tagAliases[name]]; ok {
+			return r
+		}
+		t, ok := labels.Match(tags, name)
+		if !ok {
+			return &td.Other
+		}
+		return rows[t.Name]
+	}
+
+	ctx := context.Background()
+	p := atlassian.New("dev")()
+	for _, f := range p.Resources(ctx) {
+		r := f()
+		resp := resource.MetadataResponse{}
+		r.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: providerTypeName}, &resp)
+
+		row := row(resp.TypeName)
+		row.Resources = append(row.Resources, resp.TypeName)
+		if _, ok := r.(resource.ResourceWithImportState); ok {
+			row.Importable = append(row.Importable, resp.TypeName)
+		}
+	}
+	for _, f := range p.DataSources(ctx) {
+		resp := datasource.MetadataResponse{}
+		f().Metadata(ctx, datasource.MetadataRequest{ProviderTypeName: providerTypeName}, &resp)
+
+		row := row(resp.TypeName)
+		row.DataSources = append(row.DataSources, resp.TypeName)
+	}
+
+	for _, r := range rows {
+		sort.Strings(r.Resources)
+		sort.Strings(r.DataSources)
+		if len(r.Resources) > 0 || len(r.DataSources) > 0 {
+			td.Covered++
+		}
+		td.Rows = append(td.Rows, *r)
+	}
+	sort.Strings(td.Other.Resources)
+	sort.Strings(td.Other.DataSources)
+	sort.SliceStable(td.Rows, func(i, j int) bool {
+		return td.Rows[i].Tag < td.Rows[j].Tag
+	})
+
+	writeTemplate(tmpl, "coverage", td)
+}
+
+func writeTemplate(body string, templateName string, td templateData) {
+	tp, err := template.New(templateName).Funcs(template.FuncMap{
+		"code": code,
+	}).Parse(body)
+	if err != nil {
+		log.Fatalf("error parsing template: %s", err)
+	}
+
+	var buffer bytes.Buffer
+	err = tp.Execute(&buffer, td)
+	if err != nil {
+		log.Fatalf("error executing template: %s", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(filename), 0755); err != nil {
+		log.Fatalf("error creating directory of file (%s): %s", filename, err)
+	}
+	if err := os.WriteFile(filename, buffer.Bytes(), 0644); err != nil {
+		log.Fatalf("error writing to file (%s): %s", filename, err)
+	}
+}
+
+// code returns the names as a list of code spans, e.g. `atlassian_jira_group`<br>`atlassian_jira_groups`.
+func code(names []string) string {
+	spans := make([]string, 0, len(names))
+	for _, n := range names {
+		spans = append(spans, "`"+n+"`")
+	}
+	return strings.Join(spans, "<br>")
+}
+
+var tmpl = `---
+page_title: "Jira API Coverage"
+subcategory: ""
+description: |-
+  The groups of the Jira Cloud platform REST API covered by the resources and data sources of the provider.
+---
+
+<!-- Generated by internal/generate/coverage/main.go; DO NOT EDIT. -->
+
+# Jira API Coverage
+
+The operations of the [Jira Cloud platform REST API](https://developer.atlassian.com/cloud/jira/platform/rest/v3/) are grouped by tags, listed below with the resources and data sources managing the objects of each group. The **Import** column tells whether the resources of the group support ` + "`terraform import`" + `.
+
+{{ .Covered }} of {{ len .Rows }} groups are covered by at least one resource or data source.
+
+| Group | Resources | Data Sources | Import |
+|-------|-----------|--------------|--------|
+{{- range .Rows }}
+| {{ .Tag }} | {{ code .Resources }} | {{ code .DataSources }} | {{ .Import }} |
+{{- end }}
+{{- with .Other }}{{ if or .Resources .DataSources }}
+
+The following resources and data sources do not match the name of a group:
+
+| Resources | Data Sources | Import |
+|-----------|--------------|--------|
+| {{ code .Resources }} | {{ code .DataSources }} | {{ .Import }} |
+{{- end }}{{ end }}
+`
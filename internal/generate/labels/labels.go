@@ -0,0 +1,219 @@
+// Package labels reads the tags of the Jira and Confluence OpenAPI specs, vendored in
+// testdata/specs, and names the per-resource labels of the repository after them.
+package labels
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// SpecsDir is the directory of the vendored OpenAPI specs, relative to the directories of the
+// generators, where go generate runs them.
+const SpecsDir = "../testdata/specs"
+
+// Product is an Atlassian product whose OpenAPI spec is vendored.
+type Product struct {
+	// Name is the name of the product in the labels, e.g. jira.
+	Name string
+	// URL is the location of the published OpenAPI spec.
+	URL string
+	// Spec is the file name of the vendored OpenAPI spec in SpecsDir.
+	Spec string
+}
+
+// Products are the products whose OpenAPI specs are vendored.
+var Products = []Product{
+	{
+		Name: "jira",
+		URL:  "https://developer.atlassian.com/cloud/jira/platform/swagger-v3.v3.json",
+		Spec: "jira.json",
+	},
+	{
+		Name: "confluence",
+		URL:  "https://developer.atlassian.com/cloud/confluence/swagger.v3.json",
+		Spec: "confluence.json",
+	},
+}
+
+// jiraCustomTags are the Jira resources without a tag of their own in the OpenAPI spec.
+var jiraCustomTags = []string{
+	"Group users",
+	"Issue custom fields",
+	"Issue field configuration items",
+	"Issue field configuration schemes",
+	"Issue field configuration scheme mappings",
+	"Permission grants",
+}
+
+// downloadTimeout is the timeout of the requests refreshing the vendored OpenAPI specs.
+const downloadTimeout = 60 * time.Second
+
+// Tag is a tag of the OpenAPI spec of a product, e.g. Issue types, grouping the operations of a
+// resource.
+type Tag struct {
+	Product string
+	Name    string
+}
+
+// Tags returns the tags of the OpenAPI specs of all products in dir, followed by the custom tags.
+// When refresh is set, the specs are downloaded to dir first.
+func Tags(dir string, refresh bool) ([]Tag, error) {
+	tags, err := SpecTags(dir, refresh)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, n := range jiraCustomTags {
+		tags = append(tags, Tag{Product: "jira", Name: n})
+	}
+
+	return tags, nil
+}
+
+// SpecTags returns the tags of the OpenAPI specs of all products in dir, without the custom tags.
+// When refresh is set, the specs are downloaded to dir first.
+func SpecTags(dir string, refresh bool) ([]Tag, error) {
+	var tags []Tag
+	for _, p := range Products {
+		filename := filepath.Join(dir, p.Spec)
+		if refresh {
+			if err := Download(p.URL, filename); err != nil {
+				return nil, err
+			}
+		}
+
+		spec, err := os.ReadFile(filename)
+		if err != nil {
+			return nil, fmt.Errorf("reading OpenAPI spec: %w", err)
+		}
+		t, err := ParseTags(p.Name, spec)
+		if err != nil {
+			return nil, fmt.Errorf("parsing OpenAPI spec (%s): %w", filename, err)
+		}
+		tags = append(tags, t...)
+	}
+
+	return tags, nil
+}
+
+// Download writes the OpenAPI spec at url to filename.
+func Download(url, filename string) error {
+	c := http.Client{Timeout: downloadTimeout}
+	resp, err := c.Get(url)
+	if err != nil {
+		return fmt.Errorf("error calling url (%s): %w", url, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("error calling url (%s): %s", url, resp.Status)
+	}
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("error reading response body: %w", err)
+	}
+
+	if err := os.WriteFile(filename, body, 0644); err != nil {
+		return fmt.Errorf("writing OpenAPI spec: %w", err)
+	}
+	return nil
+}
+
+// apps matches the tags of the operations reserved to Connect and Forge apps, which have no
+// resources.
+var apps = regexp.MustCompile(`\(apps\)`)
+
+// ParseTags returns the tags of the OpenAPI spec of the product, except the tags of the
+// operations reserved to apps.
+func ParseTags(product string, spec []byte) ([]Tag, error) {
+	var result struct {
+		Tags []struct {
+			Name string `json:"name"`
+		} `json:"tags"`
+	}
+	if err := json.Unmarshal(spec, &result); err != nil {
+		return nil, err
+	}
+	if len(result.Tags) == 0 {
+		return nil, fmt.Errorf("no tags found")
+	}
+
+	var tags []Tag
+	for _, t := range result.Tags {
+		if apps.MatchString(t.Name) {
+			continue
+		}
+		tags = append(tags, Tag{Product: product, Name: t.Name})
+	}
+	return tags, nil
+}
+
+// Label returns the name of the label of the tag, e.g. jira/issuetypes.
+func (t Tag) Label() string {
+	return t.Product + "/" + strings.ToLower(sr.Replace(t.Name))
+}
+
+// SnakeCaseSingular returns the name of the resource of the tag in snake case, without the
+// provider prefix, e.g. jira_issue_type.
+func (t Tag) SnakeCaseSingular() string {
+	return t.Product + "_" + singularizeLabelSuffix(t.Name)
+}
+
+var (
+	sr  = strings.NewReplacer(" ", "", "-", "")
+	sr2 = strings.NewReplacer(" - ", " ", "-", " ")
+	sr3 = strings.NewReplacer(" ", "_")
+	sr4 = strings.NewReplacer("__", "_")
+
+	ies = regexp.MustCompile(`.*ies$`)                                        // match: propert[ies]
+	s   = regexp.MustCompile(`.*[^aeiou]s$|.*[aeiouy][^s]es$|.*[aeiou]{2}s$`) // match: workflow[s] or module[s] or  issue[s]
+	ses = regexp.MustCompile(`.*ses$`)                                        // match: statu[ses]
+	es  = regexp.MustCompile(`.*[^aeiou]{2}es`)                               // match: watch[es], bush[es]
+)
+
+// Match returns the tag of the resource or data source with the given type name, without the
+// provider prefix, e.g. jira_issue_type_scheme_association or jira_groups: the tag whose
+// SnakeCaseSingular is the longest prefix of the type name with its last word in the singular.
+func Match(tags []Tag, typeName string) (Tag, bool) {
+	name := typeName
+	if i := strings.LastIndex(name, "_"); i >= 0 {
+		name = name[:i+1] + singularizeLabelSuffix(name[i+1:])
+	}
+
+	var match Tag
+	found := false
+	for _, t := range tags {
+		prefix := t.SnakeCaseSingular()
+		if name != prefix && !strings.HasPrefix(name, prefix+"_") {
+			continue
+		}
+		if !found || len(prefix) > len(match.SnakeCaseSingular()) {
+			match = t
+			found = true
+		}
+	}
+	return match, found
+}
+
+// singularizeLabelSuffix returns the name of a tag in snake case, with its last word in the
+// singular, e.g. issue_type for Issue types.
+func singularizeLabelSuffix(input string) string {
+	snake := strings.ToLower(sr4.Replace(sr3.Replace(sr2.Replace(input))))
+	if ies.MatchString(input) {
+		return strings.TrimSuffix(snake, "ies") + "y"
+	} else if s.MatchString(input) {
+		return strings.TrimSuffix(snake, "s")
+	} else if ses.MatchString(input) {
+		return strings.TrimSuffix(snake, "es")
+	} else if es.MatchString(input) {
+		return strings.TrimSuffix(snake, "es")
+	}
+	return snake
+}
@@ -0,0 +1,166 @@
+package labels
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestSingularizeLabelSuffix(t *testing.T) {
+	tests := []struct {
+		TestName string
+		Input    string
+		Expect   string
+	}{
+		{TestName: "ies", Input: "Issue properties", Expect: "issue_property"},
+		{TestName: "s", Input: "Workflows", Expect: "workflow"},
+		{TestName: "vowel es", Input: "Issue types", Expect: "issue_type"},
+		{TestName: "double vowel s", Input: "Issues", Expect: "issue"},
+		{TestName: "ses", Input: "Statuses", Expect: "status"},
+		{TestName: "consonants es", Input: "Content watches", Expect: "content_watch"},
+		{TestName: "singular", Input: "Audit", Expect: "audit"},
+		{TestName: "dash", Input: "Content - children and descendants", Expect: "content_children_and_descendant"},
+		{TestName: "custom", Input: "issue-field-configuration-scheme-mappings", Expect: "issue_field_configuration_scheme_mapping"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.TestName, func(t *testing.T) {
+			result := singularizeLabelSuffix(tt.Input)
+			if result != tt.Expect {
+				t.Errorf("got %s, expected %s", result, tt.Expect)
+			}
+		})
+	}
+}
+
+func TestTag(t *testing.T) {
+	tests := []struct {
+		TestName string
+		Input    Tag
+		Expect   string
+	}{
+		{TestName: "spec tag", Input: Tag{Product: "jira", Name: "Issue types"}, Expect: "jira/issuetypes jira_issue_type"},
+		{TestName: "custom tag", Input: Tag{Product: "jira", Name: "group-users"}, Expect: "jira/groupusers jira_group_user"},
+		{TestName: "confluence", Input: Tag{Product: "confluence", Name: "Content - attachments"}, Expect: "confluence/contentattachments confluence_content_attachment"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.TestName, func(t *testing.T) {
+			result := tt.Input.Label() + " " + tt.Input.SnakeCaseSingular()
+			if result != tt.Expect {
+				t.Errorf("got %s, expected %s", result, tt.Expect)
+			}
+		})
+	}
+}
+
+func TestParseTags(t *testing.T) {
+	tests := []struct {
+		TestName string
+		Input    string
+		Expect   string
+	}{
+		{
+			TestName: "apps",
+			Input:    `{"tags": [{"name": "Issue types"}, {"name": "App properties (apps)"}, {"name": "Workflows"}]}`,
+			Expect:   "Issue types,Workflows",
+		},
+		{
+			TestName: "no tags",
+			Input:    `{"openapi": "3.0.1"}`,
+			Expect:   "no tags found",
+		},
+		{
+			TestName: "invalid",
+			Input:    `{"tags": [`,
+			Expect:   "unexpected end of JSON input",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.TestName, func(t *testing.T) {
+			tags, err := ParseTags("jira", []byte(tt.Input))
+			var names []string
+			for _, tag := range tags {
+				names = append(names, tag.Name)
+			}
+			result := strings.Join(names, ",")
+			if err != nil {
+				result = err.Error()
+			}
+			if result != tt.Expect {
+				t.Errorf("got %s, expected %s", result, tt.Expect)
+			}
+		})
+	}
+}
+
+func TestTags_Vendored(t *testing.T) {
+	tags, err := Tags(filepath.Join("..", "testdata", "specs"), false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	seen := map[string]bool{}
+	for _, tag := range tags {
+		seen[tag.Product] = true
+	}
+	for _, p := range Products {
+		if !seen[p.Name] {
+			t.Errorf("no tags found for %s", p.Name)
+		}
+	}
+	if last := tags[len(tags)-1]; last.Name != jiraCustomTags[len(jiraCustomTags)-1] {
+		t.Errorf("got last tag %s, expected the custom tags last", last.Name)
+	}
+}
+
+func TestSpecTags_Vendored(t *testing.T) {
+	tags, err := SpecTags(filepath.Join("..", "testdata", "specs"), false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tag := range tags {
+		for _, n := range jiraCustomTags {
+			if tag.Product == "jira" && tag.Name == n {
+				t.Errorf("got custom tag %s, expected the tags of the specs only", n)
+			}
+		}
+	}
+}
+
+func TestMatch(t *testing.T) {
+	tags := []Tag{
+		{Product: "jira", Name: "Groups"},
+		{Product: "jira", Name: "Group users"},
+		{Product: "jira", Name: "Issue types"},
+		{Product: "jira", Name: "Issue type schemes"},
+		{Product: "jira", Name: "Project categories"},
+		{Product: "jira", Name: "Status"},
+	}
+
+	tests := []struct {
+		TestName string
+		Input    string
+		Expect   string
+	}{
+		{TestName: "exact", Input: "jira_group", Expect: "Groups"},
+		{TestName: "longest", Input: "jira_group_user", Expect: "Group users"},
+		{TestName: "plural", Input: "jira_groups", Expect: "Groups"},
+		{TestName: "plural ies", Input: "jira_project_categories", Expect: "Project categories"},
+		{TestName: "plural ses", Input: "jira_statuses", Expect: "Status"},
+		{TestName: "association", Input: "jira_issue_type_scheme_association", Expect: "Issue type schemes"},
+		{TestName: "word boundary", Input: "jira_groupings", Expect: ""},
+		{TestName: "no tag", Input: "jira_issue_screen", Expect: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.TestName, func(t *testing.T) {
+			tag, ok := Match(tags, tt.Input)
+			if ok != (tt.Expect != "") || tag.Name != tt.Expect {
+				t.Errorf("got %q (%t), expected %q", tag.Name, ok, tt.Expect)
+			}
+		})
+	}
+}
@@ -4,17 +4,15 @@ package main
 
 import (
 	"bytes"
-	"encoding/json"
+	"flag"
 	"fmt"
-	"io/ioutil"
 	"log"
-	"net/http"
 	"os"
-	"regexp"
 	"sort"
 	"strings"
 	"text/template"
-	"time"
+
+	"github.com/openscientia/terraform-provider-atlassian/internal/generate/labels"
 )
 
 const (
@@ -30,23 +28,20 @@ type templateData struct {
 }
 
 func main() {
-	fmt.Printf("Generating %s\n", strings.TrimPrefix(filename, "../../../"))
+	refresh := flag.Bool("refresh", false, "download the OpenAPI specs to "+labels.SpecsDir+" before generating")
+	flag.Parse()
 
-	productUrls := []string{
-		"https://developer.atlassian.com/cloud/jira/platform/swagger-v3.v3.json",
-		"https://developer.atlassian.com/cloud/confluence/swagger.v3.json",
-	}
+	fmt.Printf("Generating %s\n", strings.TrimPrefix(filename, "../../../"))
 
-	lbs := []Resource{}
-	for _, v := range productUrls {
-		lbs = append(lbs, getResources(v)...)
+	tags, err := labels.Tags(labels.SpecsDir, *refresh)
+	if err != nil {
+		log.Fatalf("error reading tags: %s", err)
 	}
 
-	// Add custom labels
-	lbs = append(lbs, getJiraCustomResources()...)
-
 	td := templateData{}
-	td.Resources = append(td.Resources, lbs...)
+	for _, t := range tags {
+		td.Resources = append(td.Resources, Resource{Name: t.Label()})
+	}
 
 	sort.SliceStable(td.Resources, func(i, j int) bool {
 		return td.Resources[i].Name < td.Resources[j].Name
@@ -55,71 +50,6 @@ func main() {
 	writeTemplate(tmpl, "repolabeler", td)
 }
 
-func getResources(url string) []Resource {
-	c := http.Client{Timeout: time.Duration(2) * time.Second}
-	resp, getErr := c.Get(url)
-	if getErr != nil {
-		log.Fatalf("error calling url (%s): %s", url, getErr)
-		return nil
-	}
-
-	defer resp.Body.Close()
-
-	body, readErr := ioutil.ReadAll(resp.Body)
-	if readErr != nil {
-		log.Fatalf("error reading response body %s", readErr)
-	}
-
-	var result map[string]interface{}
-	parseErr := json.Unmarshal(body, &result)
-	if parseErr != nil {
-		log.Fatalf("error unmarshalling JSON response %s", parseErr)
-		return nil
-	}
-	resp.Body.Close()
-
-	r := regexp.MustCompile(`\(apps\)`)
-	r2 := regexp.MustCompile(`jira|confluence`)
-	productName := r2.FindString(url)
-	var tags = result["tags"].([]interface{})
-	var resources []Resource
-	replacer := strings.NewReplacer(" ", "", "-", "")
-	for _, value := range tags {
-		// Each value is an interface{} type, that is type asserted as map[string]interface{}
-		// due to nested objects in the original JSON response
-		m := value.(map[string]interface{})
-		rawLabel := m["name"]
-		ok := r.MatchString(rawLabel.(string))
-		if ok {
-			continue
-		}
-		rsc := Resource{}
-		rsc.Name = productName + "/" + strings.ToLower(replacer.Replace(rawLabel.(string)))
-		resources = append(resources, rsc)
-	}
-
-	return resources
-}
-
-func getJiraCustomResources() []Resource {
-	var customResources []Resource
-	resources := []string{
-		"groupusers",
-		"issuecustomfields",
-		"issuefieldconfigurationitems",
-		"issuefieldconfigurationschemes",
-		"issuefieldconfigurationschememappings",
-		"permissiongrants",
-	}
-	for _, r := range resources {
-		customResources = append(customResources, Resource{
-			Name: fmt.Sprintf("jira/%s", r),
-		})
-	}
-
-	return customResources
-}
-
 func writeTemplate(body string, templateName string, td templateData) {
 	// If the file doesn't exist, create it, or append to the file
 	f, err := os.OpenFile(filename, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
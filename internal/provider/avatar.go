@@ -0,0 +1,146 @@
+package atlassian
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	jira "github.com/ctreminiom/go-atlassian/jira/v3"
+	"github.com/ctreminiom/go-atlassian/pkg/infra/models"
+)
+
+// Types of the entities that can own avatars.
+var jiraAvatarTypes = []string{"issuetype", "project", "priority"}
+
+type (
+	jiraAvatar struct {
+		ID             string                  `json:"id"`
+		Owner          string                  `json:"owner,omitempty"`
+		IsSystemAvatar bool                    `json:"isSystemAvatar"`
+		IsSelected     bool                    `json:"isSelected"`
+		IsDeletable    bool                    `json:"isDeletable"`
+		FileName       string                  `json:"fileName,omitempty"`
+		Urls           *models.AvatarURLScheme `json:"urls,omitempty"`
+	}
+
+	jiraAvatars struct {
+		System []*jiraAvatar `json:"system"`
+		Custom []*jiraAvatar `json:"custom"`
+	}
+)
+
+// jiraAvatarContentType returns the content type of an avatar image based on its file extension.
+// Only PNG and SVG images are supported.
+func jiraAvatarContentType(source string) (string, error) {
+	switch strings.ToLower(filepath.Ext(source)) {
+	case ".png":
+		return "image/png", nil
+	case ".svg":
+		return "image/svg+xml", nil
+	}
+	return "", fmt.Errorf("unsupported avatar image %q, expected a .png or .svg file", source)
+}
+
+// jiraAvatarSourceHash returns the hex encoded SHA256 hash of the avatar image at source.
+func jiraAvatarSourceHash(source string) (string, error) {
+	f, err := os.Open(source)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// uploadJiraAvatar loads a custom avatar for an entity, cropping the image to the square
+// defined by x, y and size (a size of 0 uses the largest square that fits the image).
+func uploadJiraAvatar(ctx context.Context, client *jira.Client, avatarType, entityId string, x, y, size int, source string) (*jiraAvatar, *models.ResponseScheme, error) {
+	contentType, err := jiraAvatarContentType(source)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	f, err := os.Open(source)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer f.Close()
+
+	params := url.Values{}
+	params.Add("x", strconv.Itoa(x))
+	params.Add("y", strconv.Itoa(y))
+	params.Add("size", strconv.Itoa(size))
+	endpoint := fmt.Sprintf("rest/api/3/universal_avatar/type/%v/owner/%v?%v", avatarType, entityId, params.Encode())
+
+	request, err := client.NewRequest(ctx, http.MethodPost, endpoint, f)
+	if err != nil {
+		return nil, nil, err
+	}
+	request.Header.Set("Content-Type", contentType)
+	request.Header.Set("X-Atlassian-Token", "no-check")
+
+	avatar := new(jiraAvatar)
+	res, err := client.Call(request, avatar)
+	if err != nil {
+		return nil, res, err
+	}
+	return avatar, res, nil
+}
+
+// getJiraAvatars returns the system and custom avatars of an entity.
+func getJiraAvatars(ctx context.Context, client *jira.Client, avatarType, entityId string) (*jiraAvatars, *models.ResponseScheme, error) {
+	endpoint := fmt.Sprintf("rest/api/3/universal_avatar/type/%v/owner/%v", avatarType, entityId)
+
+	request, err := client.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	avatars := new(jiraAvatars)
+	res, err := client.Call(request, avatars)
+	if err != nil {
+		return nil, res, err
+	}
+	return avatars, res, nil
+}
+
+// getJiraSystemAvatars returns the system avatars of an avatar type.
+func getJiraSystemAvatars(ctx context.Context, client *jira.Client, avatarType string) ([]*jiraAvatar, *models.ResponseScheme, error) {
+	endpoint := fmt.Sprintf("rest/api/3/avatar/%v/system", avatarType)
+
+	request, err := client.NewRequest(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	avatars := new(jiraAvatars)
+	res, err := client.Call(request, avatars)
+	if err != nil {
+		return nil, res, err
+	}
+	return avatars.System, res, nil
+}
+
+// deleteJiraAvatar deletes a custom avatar of an entity.
+func deleteJiraAvatar(ctx context.Context, client *jira.Client, avatarType, entityId, avatarId string) (*models.ResponseScheme, error) {
+	endpoint := fmt.Sprintf("rest/api/3/universal_avatar/type/%v/owner/%v/avatar/%v", avatarType, entityId, avatarId)
+
+	request, err := client.NewRequest(ctx, http.MethodDelete, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.Call(request, nil)
+}
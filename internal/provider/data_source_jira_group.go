@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 
-	jira "github.com/ctreminiom/go-atlassian/jira/v3"
 	"github.com/ctreminiom/go-atlassian/pkg/infra/models"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -24,6 +23,8 @@ type (
 		GroupID types.String `tfsdk:"group_id"`
 		Self    types.String `tfsdk:"self"`
 		Users   types.Set    `tfsdk:"users"`
+
+		Site types.String `tfsdk:"site"`
 	}
 )
 
@@ -117,6 +118,7 @@ func (*jiraGroupDataSource) Schema(_ context.Context, _ datasource.SchemaRequest
 					},
 				},
 			},
+			"site": dataSourceSiteAttribute(),
 		},
 	}
 }
@@ -127,17 +129,17 @@ func (d *jiraGroupDataSource) Configure(ctx context.Context, req datasource.Conf
 		return
 	}
 
-	client, ok := req.ProviderData.(*jira.Client)
+	p, ok := req.ProviderData.(*atlassianProvider)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *jira.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *atlassianProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	d.p.jira = client
+	d.p = *p
 }
 
 func (d *jiraGroupDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -148,6 +150,7 @@ func (d *jiraGroupDataSource) Read(ctx context.Context, req datasource.ReadReque
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	ctx = withSite(ctx, newState.Site)
 	tflog.Debug(ctx, "Loaded group config", map[string]interface{}{
 		"readConfig": fmt.Sprintf("%+v", newState),
 	})
@@ -157,11 +160,7 @@ func (d *jiraGroupDataSource) Read(ctx context.Context, req datasource.ReadReque
 	}
 	group, res, err := d.p.jira.Group.Bulk(ctx, opts, 0, 1)
 	if err != nil {
-		var resBody string
-		if res != nil {
-			resBody = res.Bytes.String()
-		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get group, got error: %s\n%s", err, resBody))
+		resp.Diagnostics.Append(clientErrorDiagnostics("get group", res, err)...)
 		return
 	}
 	tflog.Debug(ctx, "Retrieved group from API state", map[string]interface{}{
@@ -175,11 +174,7 @@ func (d *jiraGroupDataSource) Read(ctx context.Context, req datasource.ReadReque
 	for !isLast {
 		groupMembers, res, err := d.p.jira.Group.Members(ctx, newState.Name.ValueString(), true, startAt, maxResults)
 		if err != nil {
-			var resBody string
-			if res != nil {
-				resBody = res.Bytes.String()
-			}
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get group members, got error: %s\n%s", err, resBody))
+			resp.Diagnostics.Append(clientErrorDiagnostics("get group members", res, err)...)
 			return
 		}
 		startAt += maxResults
@@ -210,7 +205,7 @@ func (d *jiraGroupDataSource) Read(ctx context.Context, req datasource.ReadReque
 
 	newState.ID = types.StringValue(group.Values[0].GroupID)
 	newState.GroupID = types.StringValue(group.Values[0].GroupID)
-	newState.Self = types.StringValue(fmt.Sprintf("https://%s/rest/api/3/group?groupId=%s", d.p.jira.Site.Host, group.Values[0].GroupID))
+	newState.Self = types.StringValue(fmt.Sprintf("https://%s/rest/api/3/group?groupId=%s", d.p.siteHost(ctx), group.Values[0].GroupID))
 	newState.Users, _ = types.SetValueFrom(ctx, newState.Users.ElementType(ctx), users)
 
 	tflog.Debug(ctx, "Storing group into the state", map[string]interface{}{
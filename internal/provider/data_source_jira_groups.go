@@ -0,0 +1,147 @@
+package atlassian
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+type (
+	jiraGroupsDataSource struct {
+		p atlassianProvider
+	}
+
+	jiraGroupsDataSourceModel struct {
+		ID        types.String           `tfsdk:"id"`
+		IDs       types.List             `tfsdk:"ids"`
+		NameRegex types.String           `tfsdk:"name_regex"`
+		Groups    []jiraGroupsGroupModel `tfsdk:"groups"`
+
+		Site types.String `tfsdk:"site"`
+	}
+
+	jiraGroupsGroupModel struct {
+		ID   types.String `tfsdk:"id"`
+		Name types.String `tfsdk:"name"`
+	}
+)
+
+var (
+	_ datasource.DataSource = (*jiraGroupsDataSource)(nil)
+)
+
+func NewJiraGroupsDataSource() datasource.DataSource {
+	return &jiraGroupsDataSource{}
+}
+
+func (*jiraGroupsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_jira_groups"
+}
+
+func (*jiraGroupsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Jira Groups Data Source",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the data source.",
+				Computed:            true,
+			},
+			"ids":        jiraListIDsAttribute("groups"),
+			"name_regex": jiraListNameRegexAttribute("groups"),
+			"groups": schema.ListNestedAttribute{
+				MarkdownDescription: "The list of groups.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the group.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the group.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"site": dataSourceSiteAttribute(),
+		},
+	}
+}
+
+func (d *jiraGroupsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	p, ok := req.ProviderData.(*atlassianProvider)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *atlassianProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.p = *p
+}
+
+func (d *jiraGroupsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Debug(ctx, "Reading groups data source")
+
+	var newState jiraGroupsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &newState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx = withSite(ctx, newState.Site)
+	tflog.Debug(ctx, "Loaded groups config", map[string]interface{}{
+		"readConfig": fmt.Sprintf("%+v", newState),
+	})
+
+	filter, diags := newJiraListFilter(ctx, newState.IDs, newState.NameRegex)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	options := &models.GroupBulkOptionsScheme{
+		GroupIDs: filter.ids,
+	}
+
+	newState.ID = types.StringValue(filter.id())
+	newState.Groups = []jiraGroupsGroupModel{}
+	isLast := false
+	startAt := 0
+	for !isLast {
+		page, res, err := d.p.jira.Group.Bulk(ctx, options, startAt, jiraListPageSize)
+		if err != nil {
+			resp.Diagnostics.Append(clientErrorDiagnostics("get groups", res, err)...)
+			return
+		}
+		startAt += jiraListPageSize
+		isLast = page.IsLast || len(page.Values) == 0
+		for _, v := range page.Values {
+			if !filter.match(v.GroupID, v.Name) {
+				continue
+			}
+			newState.Groups = append(newState.Groups, jiraGroupsGroupModel{
+				ID:   types.StringValue(v.GroupID),
+				Name: types.StringValue(v.Name),
+			})
+		}
+	}
+	tflog.Debug(ctx, "Retrieved groups from API state")
+
+	tflog.Debug(ctx, "Storing groups into the state", map[string]interface{}{
+		"readNewState": fmt.Sprintf("%+v", newState),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+}
@@ -0,0 +1,80 @@
+package atlassian
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccJiraGroupsDataSource_Basic(t *testing.T) {
+	randomName := acctest.RandomWithPrefix("tf-test-groups")
+	dataSourceName := "data.atlassian_jira_groups.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Filter by name
+			{
+				Config: testAccJiraGroupsDataSourceConfig_nameRegex(randomName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "groups.#", "1"),
+					resource.TestCheckResourceAttrPair("atlassian_jira_group.test", "id", dataSourceName, "groups.0.id"),
+					resource.TestCheckResourceAttr(dataSourceName, "groups.0.name", randomName),
+				),
+			},
+			// Filter by ID
+			{
+				Config: testAccJiraGroupsDataSourceConfig_ids(randomName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "groups.#", "1"),
+					resource.TestCheckResourceAttrPair("atlassian_jira_group.test", "id", dataSourceName, "groups.0.id"),
+					resource.TestCheckResourceAttr(dataSourceName, "groups.0.name", randomName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccJiraGroupsDataSource_ErrorCases(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					data "atlassian_jira_groups" "test" {
+						name_regex = "["
+					}
+				`,
+				ExpectError: regexp.MustCompile("Invalid Regular Expression"),
+			},
+		},
+	})
+}
+
+func testAccJiraGroupsDataSourceConfig_nameRegex(name string) string {
+	return fmt.Sprintf(`
+	resource "atlassian_jira_group" "test" {
+		name = %[1]q
+	}
+
+	data "atlassian_jira_groups" "test" {
+		name_regex = "^%[1]s$"
+		depends_on = [atlassian_jira_group.test]
+	}
+	`, name)
+}
+
+func testAccJiraGroupsDataSourceConfig_ids(name string) string {
+	return fmt.Sprintf(`
+	resource "atlassian_jira_group" "test" {
+		name = %[1]q
+	}
+
+	data "atlassian_jira_groups" "test" {
+		ids = [atlassian_jira_group.test.id]
+	}
+	`, name)
+}
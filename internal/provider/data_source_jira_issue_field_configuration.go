@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"strconv"
 
-	jira "github.com/ctreminiom/go-atlassian/jira/v3"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -22,6 +21,8 @@ type (
 		ID          types.String `tfsdk:"id"`
 		Name        types.String `tfsdk:"name"`
 		Description types.String `tfsdk:"description"`
+
+		Site types.String `tfsdk:"site"`
 	}
 )
 
@@ -53,6 +54,7 @@ func (*jiraIssueFieldConfigurationDataSource) Schema(_ context.Context, _ dataso
 				MarkdownDescription: "The description of the issue field configuration.",
 				Computed:            true,
 			},
+			"site": dataSourceSiteAttribute(),
 		},
 	}
 }
@@ -63,17 +65,17 @@ func (d *jiraIssueFieldConfigurationDataSource) Configure(ctx context.Context, r
 		return
 	}
 
-	client, ok := req.ProviderData.(*jira.Client)
+	p, ok := req.ProviderData.(*atlassianProvider)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *jira.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *atlassianProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	d.p.jira = client
+	d.p = *p
 }
 
 func (d *jiraIssueFieldConfigurationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -84,6 +86,7 @@ func (d *jiraIssueFieldConfigurationDataSource) Read(ctx context.Context, req da
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	ctx = withSite(ctx, newState.Site)
 	tflog.Debug(ctx, "Loaded issue field configuration config", map[string]interface{}{
 		"readConfig": fmt.Sprintf("%+v", newState),
 	})
@@ -96,11 +99,7 @@ func (d *jiraIssueFieldConfigurationDataSource) Read(ctx context.Context, req da
 
 	issueFieldConfiguration, res, err := d.p.jira.Issue.Field.Configuration.Gets(ctx, []int{issueFieldConfigurationId}, false, 0, 50)
 	if err != nil {
-		var resBody string
-		if res != nil {
-			resBody = res.Bytes.String()
-		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get issue field configuration, got error: %s\n%s", err, resBody))
+		resp.Diagnostics.Append(clientErrorDiagnostics("get issue field configuration", res, err)...)
 		return
 	}
 	tflog.Debug(ctx, "Retrieved issue field configuration from API state", map[string]interface{}{
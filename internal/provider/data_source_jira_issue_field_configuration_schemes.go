@@ -0,0 +1,154 @@
+package atlassian
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+type (
+	jiraIssueFieldConfigurationSchemesDataSource struct {
+		p atlassianProvider
+	}
+
+	jiraIssueFieldConfigurationSchemesDataSourceModel struct {
+		ID                             types.String                                                           `tfsdk:"id"`
+		IDs                            types.List                                                             `tfsdk:"ids"`
+		NameRegex                      types.String                                                           `tfsdk:"name_regex"`
+		IssueFieldConfigurationSchemes []jiraIssueFieldConfigurationSchemesIssueFieldConfigurationSchemeModel `tfsdk:"issue_field_configuration_schemes"`
+
+		Site types.String `tfsdk:"site"`
+	}
+
+	jiraIssueFieldConfigurationSchemesIssueFieldConfigurationSchemeModel struct {
+		ID          types.String `tfsdk:"id"`
+		Name        types.String `tfsdk:"name"`
+		Description types.String `tfsdk:"description"`
+	}
+)
+
+var (
+	_ datasource.DataSource = (*jiraIssueFieldConfigurationSchemesDataSource)(nil)
+)
+
+func NewJiraIssueFieldConfigurationSchemesDataSource() datasource.DataSource {
+	return &jiraIssueFieldConfigurationSchemesDataSource{}
+}
+
+func (*jiraIssueFieldConfigurationSchemesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_jira_issue_field_configuration_schemes"
+}
+
+func (*jiraIssueFieldConfigurationSchemesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Jira Issue Field Configuration Schemes Data Source",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the data source.",
+				Computed:            true,
+			},
+			"ids":        jiraListIDsAttribute("issue field configuration schemes"),
+			"name_regex": jiraListNameRegexAttribute("issue field configuration schemes"),
+			"issue_field_configuration_schemes": schema.ListNestedAttribute{
+				MarkdownDescription: "The list of issue field configuration schemes.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the issue field configuration scheme.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the issue field configuration scheme.",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "The description of the issue field configuration scheme.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"site": dataSourceSiteAttribute(),
+		},
+	}
+}
+
+func (d *jiraIssueFieldConfigurationSchemesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	p, ok := req.ProviderData.(*atlassianProvider)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *atlassianProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.p = *p
+}
+
+func (d *jiraIssueFieldConfigurationSchemesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Debug(ctx, "Reading issue field configuration schemes data source")
+
+	var newState jiraIssueFieldConfigurationSchemesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &newState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx = withSite(ctx, newState.Site)
+	tflog.Debug(ctx, "Loaded issue field configuration schemes config", map[string]interface{}{
+		"readConfig": fmt.Sprintf("%+v", newState),
+	})
+
+	filter, diags := newJiraListFilter(ctx, newState.IDs, newState.NameRegex)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ids, diags := filter.intIDs()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	newState.ID = types.StringValue(filter.id())
+	newState.IssueFieldConfigurationSchemes = []jiraIssueFieldConfigurationSchemesIssueFieldConfigurationSchemeModel{}
+	isLast := false
+	startAt := 0
+	for !isLast {
+		page, res, err := d.p.jira.Issue.Field.Configuration.Scheme.Gets(ctx, ids, startAt, jiraListPageSize)
+		if err != nil {
+			resp.Diagnostics.Append(clientErrorDiagnostics("get issue field configuration schemes", res, err)...)
+			return
+		}
+		startAt += jiraListPageSize
+		isLast = page.IsLast || len(page.Values) == 0
+		for _, v := range page.Values {
+			if !filter.match(v.ID, v.Name) {
+				continue
+			}
+			newState.IssueFieldConfigurationSchemes = append(newState.IssueFieldConfigurationSchemes, jiraIssueFieldConfigurationSchemesIssueFieldConfigurationSchemeModel{
+				ID:          types.StringValue(v.ID),
+				Name:        types.StringValue(v.Name),
+				Description: types.StringValue(v.Description),
+			})
+		}
+	}
+	tflog.Debug(ctx, "Retrieved issue field configuration schemes from API state")
+
+	tflog.Debug(ctx, "Storing issue field configuration schemes into the state", map[string]interface{}{
+		"readNewState": fmt.Sprintf("%+v", newState),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+}
@@ -0,0 +1,63 @@
+package atlassian
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccJiraIssueFieldConfigurationSchemesDataSource_Basic(t *testing.T) {
+	randomName := acctest.RandomWithPrefix("tf-test-issue-field-configuration-schemes")
+	dataSourceName := "data.atlassian_jira_issue_field_configuration_schemes.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Filter by name
+			{
+				Config: testAccJiraIssueFieldConfigurationSchemesDataSourceConfig_nameRegex(randomName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "issue_field_configuration_schemes.#", "1"),
+					resource.TestCheckResourceAttrPair("atlassian_jira_issue_field_configuration_scheme.test", "id", dataSourceName, "issue_field_configuration_schemes.0.id"),
+					resource.TestCheckResourceAttr(dataSourceName, "issue_field_configuration_schemes.0.name", randomName),
+				),
+			},
+			// Filter by ID
+			{
+				Config: testAccJiraIssueFieldConfigurationSchemesDataSourceConfig_ids(randomName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "issue_field_configuration_schemes.#", "1"),
+					resource.TestCheckResourceAttrPair("atlassian_jira_issue_field_configuration_scheme.test", "id", dataSourceName, "issue_field_configuration_schemes.0.id"),
+					resource.TestCheckResourceAttr(dataSourceName, "issue_field_configuration_schemes.0.name", randomName),
+				),
+			},
+		},
+	})
+}
+
+func testAccJiraIssueFieldConfigurationSchemesDataSourceConfig_nameRegex(name string) string {
+	return fmt.Sprintf(`
+	resource "atlassian_jira_issue_field_configuration_scheme" "test" {
+		name = %[1]q
+	}
+
+	data "atlassian_jira_issue_field_configuration_schemes" "test" {
+		name_regex = "^%[1]s$"
+		depends_on = [atlassian_jira_issue_field_configuration_scheme.test]
+	}
+	`, name)
+}
+
+func testAccJiraIssueFieldConfigurationSchemesDataSourceConfig_ids(name string) string {
+	return fmt.Sprintf(`
+	resource "atlassian_jira_issue_field_configuration_scheme" "test" {
+		name = %[1]q
+	}
+
+	data "atlassian_jira_issue_field_configuration_schemes" "test" {
+		ids = [atlassian_jira_issue_field_configuration_scheme.test.id]
+	}
+	`, name)
+}
@@ -0,0 +1,161 @@
+package atlassian
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+type (
+	jiraIssueFieldConfigurationsDataSource struct {
+		p atlassianProvider
+	}
+
+	jiraIssueFieldConfigurationsDataSourceModel struct {
+		ID                       types.String                                               `tfsdk:"id"`
+		IDs                      types.List                                                 `tfsdk:"ids"`
+		NameRegex                types.String                                               `tfsdk:"name_regex"`
+		IssueFieldConfigurations []jiraIssueFieldConfigurationsIssueFieldConfigurationModel `tfsdk:"issue_field_configurations"`
+
+		Site types.String `tfsdk:"site"`
+	}
+
+	jiraIssueFieldConfigurationsIssueFieldConfigurationModel struct {
+		ID          types.String `tfsdk:"id"`
+		Name        types.String `tfsdk:"name"`
+		Description types.String `tfsdk:"description"`
+		IsDefault   types.Bool   `tfsdk:"is_default"`
+	}
+)
+
+var (
+	_ datasource.DataSource = (*jiraIssueFieldConfigurationsDataSource)(nil)
+)
+
+func NewJiraIssueFieldConfigurationsDataSource() datasource.DataSource {
+	return &jiraIssueFieldConfigurationsDataSource{}
+}
+
+func (*jiraIssueFieldConfigurationsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_jira_issue_field_configurations"
+}
+
+func (*jiraIssueFieldConfigurationsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Jira Issue Field Configurations Data Source",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the data source.",
+				Computed:            true,
+			},
+			"ids":        jiraListIDsAttribute("issue field configurations"),
+			"name_regex": jiraListNameRegexAttribute("issue field configurations"),
+			"issue_field_configurations": schema.ListNestedAttribute{
+				MarkdownDescription: "The list of issue field configurations.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the issue field configuration.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the issue field configuration.",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "The description of the issue field configuration.",
+							Computed:            true,
+						},
+						"is_default": schema.BoolAttribute{
+							MarkdownDescription: "Whether the issue field configuration is the default.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"site": dataSourceSiteAttribute(),
+		},
+	}
+}
+
+func (d *jiraIssueFieldConfigurationsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	p, ok := req.ProviderData.(*atlassianProvider)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *atlassianProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.p = *p
+}
+
+func (d *jiraIssueFieldConfigurationsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Debug(ctx, "Reading issue field configurations data source")
+
+	var newState jiraIssueFieldConfigurationsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &newState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx = withSite(ctx, newState.Site)
+	tflog.Debug(ctx, "Loaded issue field configurations config", map[string]interface{}{
+		"readConfig": fmt.Sprintf("%+v", newState),
+	})
+
+	filter, diags := newJiraListFilter(ctx, newState.IDs, newState.NameRegex)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ids, diags := filter.intIDs()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	newState.ID = types.StringValue(filter.id())
+	newState.IssueFieldConfigurations = []jiraIssueFieldConfigurationsIssueFieldConfigurationModel{}
+	isLast := false
+	startAt := 0
+	for !isLast {
+		page, res, err := d.p.jira.Issue.Field.Configuration.Gets(ctx, ids, false, startAt, jiraListPageSize)
+		if err != nil {
+			resp.Diagnostics.Append(clientErrorDiagnostics("get issue field configurations", res, err)...)
+			return
+		}
+		startAt += jiraListPageSize
+		isLast = page.IsLast || len(page.Values) == 0
+		for _, v := range page.Values {
+			if !filter.match(strconv.Itoa(v.ID), v.Name) {
+				continue
+			}
+			newState.IssueFieldConfigurations = append(newState.IssueFieldConfigurations, jiraIssueFieldConfigurationsIssueFieldConfigurationModel{
+				ID:          types.StringValue(strconv.Itoa(v.ID)),
+				Name:        types.StringValue(v.Name),
+				Description: types.StringValue(v.Description),
+				IsDefault:   types.BoolValue(v.IsDefault),
+			})
+		}
+	}
+	tflog.Debug(ctx, "Retrieved issue field configurations from API state")
+
+	tflog.Debug(ctx, "Storing issue field configurations into the state", map[string]interface{}{
+		"readNewState": fmt.Sprintf("%+v", newState),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+}
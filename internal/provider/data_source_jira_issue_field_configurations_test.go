@@ -0,0 +1,63 @@
+package atlassian
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccJiraIssueFieldConfigurationsDataSource_Basic(t *testing.T) {
+	randomName := acctest.RandomWithPrefix("tf-test-issue-field-configurations")
+	dataSourceName := "data.atlassian_jira_issue_field_configurations.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Filter by name
+			{
+				Config: testAccJiraIssueFieldConfigurationsDataSourceConfig_nameRegex(randomName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "issue_field_configurations.#", "1"),
+					resource.TestCheckResourceAttrPair("atlassian_jira_issue_field_configuration.test", "id", dataSourceName, "issue_field_configurations.0.id"),
+					resource.TestCheckResourceAttr(dataSourceName, "issue_field_configurations.0.name", randomName),
+				),
+			},
+			// Filter by ID
+			{
+				Config: testAccJiraIssueFieldConfigurationsDataSourceConfig_ids(randomName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "issue_field_configurations.#", "1"),
+					resource.TestCheckResourceAttrPair("atlassian_jira_issue_field_configuration.test", "id", dataSourceName, "issue_field_configurations.0.id"),
+					resource.TestCheckResourceAttr(dataSourceName, "issue_field_configurations.0.name", randomName),
+				),
+			},
+		},
+	})
+}
+
+func testAccJiraIssueFieldConfigurationsDataSourceConfig_nameRegex(name string) string {
+	return fmt.Sprintf(`
+	resource "atlassian_jira_issue_field_configuration" "test" {
+		name = %[1]q
+	}
+
+	data "atlassian_jira_issue_field_configurations" "test" {
+		name_regex = "^%[1]s$"
+		depends_on = [atlassian_jira_issue_field_configuration.test]
+	}
+	`, name)
+}
+
+func testAccJiraIssueFieldConfigurationsDataSourceConfig_ids(name string) string {
+	return fmt.Sprintf(`
+	resource "atlassian_jira_issue_field_configuration" "test" {
+		name = %[1]q
+	}
+
+	data "atlassian_jira_issue_field_configurations" "test" {
+		ids = [atlassian_jira_issue_field_configuration.test.id]
+	}
+	`, name)
+}
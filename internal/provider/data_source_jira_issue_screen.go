@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"strconv"
 
-	jira "github.com/ctreminiom/go-atlassian/jira/v3"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -21,6 +20,8 @@ type (
 		ID          types.String `tfsdk:"id"`
 		Name        types.String `tfsdk:"name"`
 		Description types.String `tfsdk:"description"`
+
+		Site types.String `tfsdk:"site"`
 	}
 )
 
@@ -55,6 +56,7 @@ func (*jiraIssueScreenDataSource) Schema(_ context.Context, _ datasource.SchemaR
 					"The maximum length is 255 characters.",
 				Computed: true,
 			},
+			"site": dataSourceSiteAttribute(),
 		},
 	}
 }
@@ -65,17 +67,17 @@ func (d *jiraIssueScreenDataSource) Configure(ctx context.Context, req datasourc
 		return
 	}
 
-	client, ok := req.ProviderData.(*jira.Client)
+	p, ok := req.ProviderData.(*atlassianProvider)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *jira.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *atlassianProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	d.p.jira = client
+	d.p = *p
 }
 
 func (d *jiraIssueScreenDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -86,6 +88,7 @@ func (d *jiraIssueScreenDataSource) Read(ctx context.Context, req datasource.Rea
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	ctx = withSite(ctx, newState.Site)
 	tflog.Debug(ctx, "Loaded issue screen config", map[string]interface{}{
 		"readConfig": fmt.Sprintf("%+v", newState),
 	})
@@ -98,11 +101,7 @@ func (d *jiraIssueScreenDataSource) Read(ctx context.Context, req datasource.Rea
 
 	issueScreen, res, err := d.p.jira.Screen.Gets(ctx, []int{issueScreenId}, 0, 50)
 	if err != nil {
-		var resBody string
-		if res != nil {
-			resBody = res.Bytes.String()
-		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get issue screen, got error: %s\n%s", err.Error(), resBody))
+		resp.Diagnostics.Append(clientErrorDiagnostics("get issue screen", res, err)...)
 		return
 	}
 	tflog.Debug(ctx, "Retrieve issue screen from API state", map[string]interface{}{
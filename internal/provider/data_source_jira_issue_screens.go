@@ -0,0 +1,155 @@
+package atlassian
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+type (
+	jiraIssueScreensDataSource struct {
+		p atlassianProvider
+	}
+
+	jiraIssueScreensDataSourceModel struct {
+		ID           types.String                       `tfsdk:"id"`
+		IDs          types.List                         `tfsdk:"ids"`
+		NameRegex    types.String                       `tfsdk:"name_regex"`
+		IssueScreens []jiraIssueScreensIssueScreenModel `tfsdk:"issue_screens"`
+
+		Site types.String `tfsdk:"site"`
+	}
+
+	jiraIssueScreensIssueScreenModel struct {
+		ID          types.String `tfsdk:"id"`
+		Name        types.String `tfsdk:"name"`
+		Description types.String `tfsdk:"description"`
+	}
+)
+
+var (
+	_ datasource.DataSource = (*jiraIssueScreensDataSource)(nil)
+)
+
+func NewJiraIssueScreensDataSource() datasource.DataSource {
+	return &jiraIssueScreensDataSource{}
+}
+
+func (*jiraIssueScreensDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_jira_issue_screens"
+}
+
+func (*jiraIssueScreensDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Jira Issue Screens Data Source",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the data source.",
+				Computed:            true,
+			},
+			"ids":        jiraListIDsAttribute("issue screens"),
+			"name_regex": jiraListNameRegexAttribute("issue screens"),
+			"issue_screens": schema.ListNestedAttribute{
+				MarkdownDescription: "The list of issue screens.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the issue screen.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the issue screen.",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "The description of the issue screen.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"site": dataSourceSiteAttribute(),
+		},
+	}
+}
+
+func (d *jiraIssueScreensDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	p, ok := req.ProviderData.(*atlassianProvider)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *atlassianProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.p = *p
+}
+
+func (d *jiraIssueScreensDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Debug(ctx, "Reading issue screens data source")
+
+	var newState jiraIssueScreensDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &newState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx = withSite(ctx, newState.Site)
+	tflog.Debug(ctx, "Loaded issue screens config", map[string]interface{}{
+		"readConfig": fmt.Sprintf("%+v", newState),
+	})
+
+	filter, diags := newJiraListFilter(ctx, newState.IDs, newState.NameRegex)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ids, diags := filter.intIDs()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	newState.ID = types.StringValue(filter.id())
+	newState.IssueScreens = []jiraIssueScreensIssueScreenModel{}
+	isLast := false
+	startAt := 0
+	for !isLast {
+		page, res, err := d.p.jira.Screen.Gets(ctx, ids, startAt, jiraListPageSize)
+		if err != nil {
+			resp.Diagnostics.Append(clientErrorDiagnostics("get issue screens", res, err)...)
+			return
+		}
+		startAt += jiraListPageSize
+		isLast = page.IsLast || len(page.Values) == 0
+		for _, v := range page.Values {
+			if !filter.match(strconv.Itoa(v.ID), v.Name) {
+				continue
+			}
+			newState.IssueScreens = append(newState.IssueScreens, jiraIssueScreensIssueScreenModel{
+				ID:          types.StringValue(strconv.Itoa(v.ID)),
+				Name:        types.StringValue(v.Name),
+				Description: types.StringValue(v.Description),
+			})
+		}
+	}
+	tflog.Debug(ctx, "Retrieved issue screens from API state")
+
+	tflog.Debug(ctx, "Storing issue screens into the state", map[string]interface{}{
+		"readNewState": fmt.Sprintf("%+v", newState),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+}
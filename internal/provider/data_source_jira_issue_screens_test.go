@@ -0,0 +1,63 @@
+package atlassian
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccJiraIssueScreensDataSource_Basic(t *testing.T) {
+	randomName := acctest.RandomWithPrefix("tf-test-issue-screens")
+	dataSourceName := "data.atlassian_jira_issue_screens.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Filter by name
+			{
+				Config: testAccJiraIssueScreensDataSourceConfig_nameRegex(randomName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "issue_screens.#", "1"),
+					resource.TestCheckResourceAttrPair("atlassian_jira_issue_screen.test", "id", dataSourceName, "issue_screens.0.id"),
+					resource.TestCheckResourceAttr(dataSourceName, "issue_screens.0.name", randomName),
+				),
+			},
+			// Filter by ID
+			{
+				Config: testAccJiraIssueScreensDataSourceConfig_ids(randomName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "issue_screens.#", "1"),
+					resource.TestCheckResourceAttrPair("atlassian_jira_issue_screen.test", "id", dataSourceName, "issue_screens.0.id"),
+					resource.TestCheckResourceAttr(dataSourceName, "issue_screens.0.name", randomName),
+				),
+			},
+		},
+	})
+}
+
+func testAccJiraIssueScreensDataSourceConfig_nameRegex(name string) string {
+	return fmt.Sprintf(`
+	resource "atlassian_jira_issue_screen" "test" {
+		name = %[1]q
+	}
+
+	data "atlassian_jira_issue_screens" "test" {
+		name_regex = "^%[1]s$"
+		depends_on = [atlassian_jira_issue_screen.test]
+	}
+	`, name)
+}
+
+func testAccJiraIssueScreensDataSourceConfig_ids(name string) string {
+	return fmt.Sprintf(`
+	resource "atlassian_jira_issue_screen" "test" {
+		name = %[1]q
+	}
+
+	data "atlassian_jira_issue_screens" "test" {
+		ids = [atlassian_jira_issue_screen.test.id]
+	}
+	`, name)
+}
@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 
-	jira "github.com/ctreminiom/go-atlassian/jira/v3"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -23,6 +22,8 @@ type (
 		HierarchyLevel types.Int64  `tfsdk:"hierarchy_level"`
 		IconURL        types.String `tfsdk:"icon_url"`
 		AvatarID       types.Int64  `tfsdk:"avatar_id"`
+
+		Site types.String `tfsdk:"site"`
 	}
 )
 
@@ -66,6 +67,7 @@ func (*jiraIssueTypeDataSource) Schema(_ context.Context, _ datasource.SchemaReq
 				MarkdownDescription: "The ID of the issue type's avatar.",
 				Computed:            true,
 			},
+			"site": dataSourceSiteAttribute(),
 		},
 	}
 }
@@ -76,17 +78,17 @@ func (d *jiraIssueTypeDataSource) Configure(ctx context.Context, req datasource.
 		return
 	}
 
-	client, ok := req.ProviderData.(*jira.Client)
+	p, ok := req.ProviderData.(*atlassianProvider)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *jira.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *atlassianProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	d.p.jira = client
+	d.p = *p
 }
 
 func (d *jiraIssueTypeDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -97,14 +99,11 @@ func (d *jiraIssueTypeDataSource) Read(ctx context.Context, req datasource.ReadR
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	ctx = withSite(ctx, newstate.Site)
 
 	issueType, res, err := d.p.jira.Issue.Type.Get(ctx, newstate.ID.ValueString())
 	if err != nil {
-		var resBody string
-		if res != nil {
-			resBody = res.Bytes.String()
-		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get issue type, got error: %s\n%s", err, resBody))
+		resp.Diagnostics.Append(clientErrorDiagnostics("get issue type", res, err)...)
 		return
 	}
 	tflog.Debug(ctx, "Retrieved issue type from API state", map[string]interface{}{
@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"strconv"
 
-	jira "github.com/ctreminiom/go-atlassian/jira/v3"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -23,6 +22,8 @@ type (
 		Description        types.String `tfsdk:"description"`
 		DefaultIssueTypeId types.String `tfsdk:"default_issue_type_id"`
 		IssueTypeIds       types.List   `tfsdk:"issue_type_ids"`
+
+		Site types.String `tfsdk:"site"`
 	}
 )
 
@@ -63,6 +64,7 @@ func (*jiraIssueTypeSchemeDataSource) Schema(_ context.Context, _ datasource.Sch
 				ElementType:         types.StringType,
 				Computed:            true,
 			},
+			"site": dataSourceSiteAttribute(),
 		},
 	}
 }
@@ -73,17 +75,17 @@ func (d *jiraIssueTypeSchemeDataSource) Configure(ctx context.Context, req datas
 		return
 	}
 
-	client, ok := req.ProviderData.(*jira.Client)
+	p, ok := req.ProviderData.(*atlassianProvider)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *jira.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *atlassianProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	d.p.jira = client
+	d.p = *p
 }
 
 func (d *jiraIssueTypeSchemeDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -94,6 +96,7 @@ func (d *jiraIssueTypeSchemeDataSource) Read(ctx context.Context, req datasource
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	ctx = withSite(ctx, newState.Site)
 	tflog.Debug(ctx, "Loaded issue type scheme config", map[string]interface{}{
 		"readConfig": fmt.Sprintf("%+v", newState),
 	})
@@ -107,22 +110,14 @@ func (d *jiraIssueTypeSchemeDataSource) Read(ctx context.Context, req datasource
 	// Get issue type scheme details
 	issueTypeScheme, res, err := d.p.jira.Issue.Type.Scheme.Gets(ctx, []int{issueTypeSchemeID}, 0, 1)
 	if err != nil {
-		var resBody string
-		if res != nil {
-			resBody = res.Bytes.String()
-		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get issue type, got error: %s\n%s", err, resBody))
+		resp.Diagnostics.Append(clientErrorDiagnostics("get issue type", res, err)...)
 		return
 	}
 
 	// Get issue type scheme items
 	issueTypeSchemeItems, res, err := d.p.jira.Issue.Type.Scheme.Items(ctx, []int{issueTypeSchemeID}, 0, 50)
 	if err != nil {
-		var resBody string
-		if res != nil {
-			resBody = res.Bytes.String()
-		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get issue type scheme items, got error: %s\n%s", err, resBody))
+		resp.Diagnostics.Append(clientErrorDiagnostics("get issue type scheme items", res, err)...)
 		return
 	}
 
@@ -0,0 +1,160 @@
+package atlassian
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+type (
+	jiraIssueTypeSchemesDataSource struct {
+		p atlassianProvider
+	}
+
+	jiraIssueTypeSchemesDataSourceModel struct {
+		ID               types.String                               `tfsdk:"id"`
+		IDs              types.List                                 `tfsdk:"ids"`
+		NameRegex        types.String                               `tfsdk:"name_regex"`
+		IssueTypeSchemes []jiraIssueTypeSchemesIssueTypeSchemeModel `tfsdk:"issue_type_schemes"`
+
+		Site types.String `tfsdk:"site"`
+	}
+
+	jiraIssueTypeSchemesIssueTypeSchemeModel struct {
+		ID                 types.String `tfsdk:"id"`
+		Name               types.String `tfsdk:"name"`
+		Description        types.String `tfsdk:"description"`
+		DefaultIssueTypeId types.String `tfsdk:"default_issue_type_id"`
+	}
+)
+
+var (
+	_ datasource.DataSource = (*jiraIssueTypeSchemesDataSource)(nil)
+)
+
+func NewJiraIssueTypeSchemesDataSource() datasource.DataSource {
+	return &jiraIssueTypeSchemesDataSource{}
+}
+
+func (*jiraIssueTypeSchemesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_jira_issue_type_schemes"
+}
+
+func (*jiraIssueTypeSchemesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Jira Issue Type Schemes Data Source",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the data source.",
+				Computed:            true,
+			},
+			"ids":        jiraListIDsAttribute("issue type schemes"),
+			"name_regex": jiraListNameRegexAttribute("issue type schemes"),
+			"issue_type_schemes": schema.ListNestedAttribute{
+				MarkdownDescription: "The list of issue type schemes.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the issue type scheme.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the issue type scheme.",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "The description of the issue type scheme.",
+							Computed:            true,
+						},
+						"default_issue_type_id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the default issue type of the issue type scheme.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"site": dataSourceSiteAttribute(),
+		},
+	}
+}
+
+func (d *jiraIssueTypeSchemesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	p, ok := req.ProviderData.(*atlassianProvider)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *atlassianProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.p = *p
+}
+
+func (d *jiraIssueTypeSchemesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Debug(ctx, "Reading issue type schemes data source")
+
+	var newState jiraIssueTypeSchemesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &newState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx = withSite(ctx, newState.Site)
+	tflog.Debug(ctx, "Loaded issue type schemes config", map[string]interface{}{
+		"readConfig": fmt.Sprintf("%+v", newState),
+	})
+
+	filter, diags := newJiraListFilter(ctx, newState.IDs, newState.NameRegex)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ids, diags := filter.intIDs()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	newState.ID = types.StringValue(filter.id())
+	newState.IssueTypeSchemes = []jiraIssueTypeSchemesIssueTypeSchemeModel{}
+	isLast := false
+	startAt := 0
+	for !isLast {
+		page, res, err := d.p.jira.Issue.Type.Scheme.Gets(ctx, ids, startAt, jiraListPageSize)
+		if err != nil {
+			resp.Diagnostics.Append(clientErrorDiagnostics("get issue type schemes", res, err)...)
+			return
+		}
+		startAt += jiraListPageSize
+		isLast = page.IsLast || len(page.Values) == 0
+		for _, v := range page.Values {
+			if !filter.match(v.ID, v.Name) {
+				continue
+			}
+			newState.IssueTypeSchemes = append(newState.IssueTypeSchemes, jiraIssueTypeSchemesIssueTypeSchemeModel{
+				ID:                 types.StringValue(v.ID),
+				Name:               types.StringValue(v.Name),
+				Description:        types.StringValue(v.Description),
+				DefaultIssueTypeId: types.StringValue(v.DefaultIssueTypeID),
+			})
+		}
+	}
+	tflog.Debug(ctx, "Retrieved issue type schemes from API state")
+
+	tflog.Debug(ctx, "Storing issue type schemes into the state", map[string]interface{}{
+		"readNewState": fmt.Sprintf("%+v", newState),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+}
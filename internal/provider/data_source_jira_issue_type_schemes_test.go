@@ -0,0 +1,73 @@
+package atlassian
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccJiraIssueTypeSchemesDataSource_Basic(t *testing.T) {
+	randomName := acctest.RandomWithPrefix("tf-test-issue-type-schemes")
+	dataSourceName := "data.atlassian_jira_issue_type_schemes.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Filter by name
+			{
+				Config: testAccJiraIssueTypeSchemesDataSourceConfig_nameRegex(randomName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "issue_type_schemes.#", "1"),
+					resource.TestCheckResourceAttrPair("atlassian_jira_issue_type_scheme.test", "id", dataSourceName, "issue_type_schemes.0.id"),
+					resource.TestCheckResourceAttr(dataSourceName, "issue_type_schemes.0.name", randomName),
+				),
+			},
+			// Filter by ID
+			{
+				Config: testAccJiraIssueTypeSchemesDataSourceConfig_ids(randomName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "issue_type_schemes.#", "1"),
+					resource.TestCheckResourceAttrPair("atlassian_jira_issue_type_scheme.test", "id", dataSourceName, "issue_type_schemes.0.id"),
+					resource.TestCheckResourceAttr(dataSourceName, "issue_type_schemes.0.name", randomName),
+				),
+			},
+		},
+	})
+}
+
+func testAccJiraIssueTypeSchemesDataSourceConfig_nameRegex(name string) string {
+	return fmt.Sprintf(`
+	resource "atlassian_jira_issue_type" "test" {
+		name = %[1]q
+	}
+
+	resource "atlassian_jira_issue_type_scheme" "test" {
+		name = %[1]q
+		issue_type_ids = [atlassian_jira_issue_type.test.id]
+	}
+
+	data "atlassian_jira_issue_type_schemes" "test" {
+		name_regex = "^%[1]s$"
+		depends_on = [atlassian_jira_issue_type_scheme.test]
+	}
+	`, name)
+}
+
+func testAccJiraIssueTypeSchemesDataSourceConfig_ids(name string) string {
+	return fmt.Sprintf(`
+	resource "atlassian_jira_issue_type" "test" {
+		name = %[1]q
+	}
+
+	resource "atlassian_jira_issue_type_scheme" "test" {
+		name = %[1]q
+		issue_type_ids = [atlassian_jira_issue_type.test.id]
+	}
+
+	data "atlassian_jira_issue_type_schemes" "test" {
+		ids = [atlassian_jira_issue_type_scheme.test.id]
+	}
+	`, name)
+}
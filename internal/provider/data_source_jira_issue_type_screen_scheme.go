@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"strconv"
 
-	jira "github.com/ctreminiom/go-atlassian/jira/v3"
 	"github.com/ctreminiom/go-atlassian/pkg/infra/models"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -24,6 +23,8 @@ type (
 		Name              types.String                       `tfsdk:"name"`
 		Description       types.String                       `tfsdk:"description"`
 		IssueTypeMappings []jiraIssueTypeScreenSchemeMapping `tfsdk:"issue_type_mappings"`
+
+		Site types.String `tfsdk:"site"`
 	}
 )
 
@@ -78,6 +79,7 @@ func (*jiraIssueTypeScreenSchemeDataSource) Schema(_ context.Context, _ datasour
 					},
 				},
 			},
+			"site": dataSourceSiteAttribute(),
 		},
 	}
 }
@@ -88,17 +90,17 @@ func (d *jiraIssueTypeScreenSchemeDataSource) Configure(ctx context.Context, req
 		return
 	}
 
-	client, ok := req.ProviderData.(*jira.Client)
+	p, ok := req.ProviderData.(*atlassianProvider)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *jira.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *atlassianProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	d.p.jira = client
+	d.p = *p
 }
 
 func (d *jiraIssueTypeScreenSchemeDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -109,6 +111,7 @@ func (d *jiraIssueTypeScreenSchemeDataSource) Read(ctx context.Context, req data
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	ctx = withSite(ctx, newState.Site)
 	tflog.Debug(ctx, "Loaded issue type screen scheme config", map[string]interface{}{
 		"readConfig": fmt.Sprintf("%+v", newState),
 	})
@@ -124,21 +127,13 @@ func (d *jiraIssueTypeScreenSchemeDataSource) Read(ctx context.Context, req data
 
 	issueTypeScreenScheme, res, err := d.p.jira.Issue.Type.ScreenScheme.Gets(ctx, options, 0, 1)
 	if err != nil {
-		var resBody string
-		if res != nil {
-			resBody = res.Bytes.String()
-		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get issue type screen scheme, got error: %s\n%s", err, resBody))
+		resp.Diagnostics.Append(clientErrorDiagnostics("get issue type screen scheme", res, err)...)
 		return
 	}
 
 	issueTypeMappings, res, err := d.p.jira.Issue.Type.ScreenScheme.Mapping(ctx, []int{issueTypeScreenSchemeId}, 0, 50)
 	if err != nil {
-		var resBody string
-		if res != nil {
-			resBody = res.Bytes.String()
-		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get issue type screen scheme mappings, got error: %s\n%s", err, resBody))
+		resp.Diagnostics.Append(clientErrorDiagnostics("get issue type screen scheme mappings", res, err)...)
 		return
 	}
 	tflog.Debug(ctx, "Retrieved issue type screen scheme from API state", map[string]interface{}{
@@ -0,0 +1,159 @@
+package atlassian
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+type (
+	jiraIssueTypeScreenSchemesDataSource struct {
+		p atlassianProvider
+	}
+
+	jiraIssueTypeScreenSchemesDataSourceModel struct {
+		ID                     types.String                                           `tfsdk:"id"`
+		IDs                    types.List                                             `tfsdk:"ids"`
+		NameRegex              types.String                                           `tfsdk:"name_regex"`
+		IssueTypeScreenSchemes []jiraIssueTypeScreenSchemesIssueTypeScreenSchemeModel `tfsdk:"issue_type_screen_schemes"`
+
+		Site types.String `tfsdk:"site"`
+	}
+
+	jiraIssueTypeScreenSchemesIssueTypeScreenSchemeModel struct {
+		ID          types.String `tfsdk:"id"`
+		Name        types.String `tfsdk:"name"`
+		Description types.String `tfsdk:"description"`
+	}
+)
+
+var (
+	_ datasource.DataSource = (*jiraIssueTypeScreenSchemesDataSource)(nil)
+)
+
+func NewJiraIssueTypeScreenSchemesDataSource() datasource.DataSource {
+	return &jiraIssueTypeScreenSchemesDataSource{}
+}
+
+func (*jiraIssueTypeScreenSchemesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_jira_issue_type_screen_schemes"
+}
+
+func (*jiraIssueTypeScreenSchemesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Jira Issue Type Screen Schemes Data Source",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the data source.",
+				Computed:            true,
+			},
+			"ids":        jiraListIDsAttribute("issue type screen schemes"),
+			"name_regex": jiraListNameRegexAttribute("issue type screen schemes"),
+			"issue_type_screen_schemes": schema.ListNestedAttribute{
+				MarkdownDescription: "The list of issue type screen schemes.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the issue type screen scheme.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the issue type screen scheme.",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "The description of the issue type screen scheme.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"site": dataSourceSiteAttribute(),
+		},
+	}
+}
+
+func (d *jiraIssueTypeScreenSchemesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	p, ok := req.ProviderData.(*atlassianProvider)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *atlassianProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.p = *p
+}
+
+func (d *jiraIssueTypeScreenSchemesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Debug(ctx, "Reading issue type screen schemes data source")
+
+	var newState jiraIssueTypeScreenSchemesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &newState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx = withSite(ctx, newState.Site)
+	tflog.Debug(ctx, "Loaded issue type screen schemes config", map[string]interface{}{
+		"readConfig": fmt.Sprintf("%+v", newState),
+	})
+
+	filter, diags := newJiraListFilter(ctx, newState.IDs, newState.NameRegex)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ids, diags := filter.intIDs()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	options := &models.ScreenSchemeParamsScheme{
+		IDs: ids,
+	}
+
+	newState.ID = types.StringValue(filter.id())
+	newState.IssueTypeScreenSchemes = []jiraIssueTypeScreenSchemesIssueTypeScreenSchemeModel{}
+	isLast := false
+	startAt := 0
+	for !isLast {
+		page, res, err := d.p.jira.Issue.Type.ScreenScheme.Gets(ctx, options, startAt, jiraListPageSize)
+		if err != nil {
+			resp.Diagnostics.Append(clientErrorDiagnostics("get issue type screen schemes", res, err)...)
+			return
+		}
+		startAt += jiraListPageSize
+		isLast = page.IsLast || len(page.Values) == 0
+		for _, v := range page.Values {
+			if !filter.match(v.ID, v.Name) {
+				continue
+			}
+			newState.IssueTypeScreenSchemes = append(newState.IssueTypeScreenSchemes, jiraIssueTypeScreenSchemesIssueTypeScreenSchemeModel{
+				ID:          types.StringValue(v.ID),
+				Name:        types.StringValue(v.Name),
+				Description: types.StringValue(v.Description),
+			})
+		}
+	}
+	tflog.Debug(ctx, "Retrieved issue type screen schemes from API state")
+
+	tflog.Debug(ctx, "Storing issue type screen schemes into the state", map[string]interface{}{
+		"readNewState": fmt.Sprintf("%+v", newState),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+}
@@ -0,0 +1,75 @@
+package atlassian
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccJiraIssueTypeScreenSchemesDataSource_Basic(t *testing.T) {
+	randomName := acctest.RandomWithPrefix("tf-test-issue-type-screen-schemes")
+	dataSourceName := "data.atlassian_jira_issue_type_screen_schemes.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Filter by name
+			{
+				Config: testAccJiraIssueTypeScreenSchemesDataSourceConfig_nameRegex(randomName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "issue_type_screen_schemes.#", "1"),
+					resource.TestCheckResourceAttrPair("atlassian_jira_issue_type_screen_scheme.test", "id", dataSourceName, "issue_type_screen_schemes.0.id"),
+					resource.TestCheckResourceAttr(dataSourceName, "issue_type_screen_schemes.0.name", randomName),
+				),
+			},
+			// Filter by ID
+			{
+				Config: testAccJiraIssueTypeScreenSchemesDataSourceConfig_ids(randomName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "issue_type_screen_schemes.#", "1"),
+					resource.TestCheckResourceAttrPair("atlassian_jira_issue_type_screen_scheme.test", "id", dataSourceName, "issue_type_screen_schemes.0.id"),
+					resource.TestCheckResourceAttr(dataSourceName, "issue_type_screen_schemes.0.name", randomName),
+				),
+			},
+		},
+	})
+}
+
+func testAccJiraIssueTypeScreenSchemesDataSourceConfig_nameRegex(name string) string {
+	return fmt.Sprintf(`
+	resource "atlassian_jira_issue_type_screen_scheme" "test" {
+		name = %[1]q
+		issue_type_mappings = [
+			{
+				issue_type_id = "default"
+				screen_scheme_id = "1"
+			}
+		]
+	}
+
+	data "atlassian_jira_issue_type_screen_schemes" "test" {
+		name_regex = "^%[1]s$"
+		depends_on = [atlassian_jira_issue_type_screen_scheme.test]
+	}
+	`, name)
+}
+
+func testAccJiraIssueTypeScreenSchemesDataSourceConfig_ids(name string) string {
+	return fmt.Sprintf(`
+	resource "atlassian_jira_issue_type_screen_scheme" "test" {
+		name = %[1]q
+		issue_type_mappings = [
+			{
+				issue_type_id = "default"
+				screen_scheme_id = "1"
+			}
+		]
+	}
+
+	data "atlassian_jira_issue_type_screen_schemes" "test" {
+		ids = [atlassian_jira_issue_type_screen_scheme.test.id]
+	}
+	`, name)
+}
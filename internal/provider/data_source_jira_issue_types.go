@@ -0,0 +1,162 @@
+package atlassian
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+type (
+	jiraIssueTypesDataSource struct {
+		p atlassianProvider
+	}
+
+	jiraIssueTypesDataSourceModel struct {
+		ID         types.String                   `tfsdk:"id"`
+		IDs        types.List                     `tfsdk:"ids"`
+		NameRegex  types.String                   `tfsdk:"name_regex"`
+		IssueTypes []jiraIssueTypesIssueTypeModel `tfsdk:"issue_types"`
+
+		Site types.String `tfsdk:"site"`
+	}
+
+	jiraIssueTypesIssueTypeModel struct {
+		ID             types.String `tfsdk:"id"`
+		Name           types.String `tfsdk:"name"`
+		Description    types.String `tfsdk:"description"`
+		HierarchyLevel types.Int64  `tfsdk:"hierarchy_level"`
+		IconUrl        types.String `tfsdk:"icon_url"`
+		AvatarId       types.Int64  `tfsdk:"avatar_id"`
+	}
+)
+
+var (
+	_ datasource.DataSource = (*jiraIssueTypesDataSource)(nil)
+)
+
+func NewJiraIssueTypesDataSource() datasource.DataSource {
+	return &jiraIssueTypesDataSource{}
+}
+
+func (*jiraIssueTypesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_jira_issue_types"
+}
+
+func (*jiraIssueTypesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Jira Issue Types Data Source",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the data source.",
+				Computed:            true,
+			},
+			"ids":        jiraListIDsAttribute("issue types"),
+			"name_regex": jiraListNameRegexAttribute("issue types"),
+			"issue_types": schema.ListNestedAttribute{
+				MarkdownDescription: "The list of issue types.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the issue type.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the issue type.",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "The description of the issue type.",
+							Computed:            true,
+						},
+						"hierarchy_level": schema.Int64Attribute{
+							MarkdownDescription: "The hierarchy level of the issue type.",
+							Computed:            true,
+						},
+						"icon_url": schema.StringAttribute{
+							MarkdownDescription: "The URL of the issue type's avatar.",
+							Computed:            true,
+						},
+						"avatar_id": schema.Int64Attribute{
+							MarkdownDescription: "The ID of the issue type's avatar.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"site": dataSourceSiteAttribute(),
+		},
+	}
+}
+
+func (d *jiraIssueTypesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	p, ok := req.ProviderData.(*atlassianProvider)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *atlassianProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.p = *p
+}
+
+func (d *jiraIssueTypesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Debug(ctx, "Reading issue types data source")
+
+	var newState jiraIssueTypesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &newState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx = withSite(ctx, newState.Site)
+	tflog.Debug(ctx, "Loaded issue types config", map[string]interface{}{
+		"readConfig": fmt.Sprintf("%+v", newState),
+	})
+
+	filter, diags := newJiraListFilter(ctx, newState.IDs, newState.NameRegex)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The issue types API is not paginated and returns every issue type
+	issueTypes, res, err := d.p.jira.Issue.Type.Gets(ctx)
+	if err != nil {
+		resp.Diagnostics.Append(clientErrorDiagnostics("get issue types", res, err)...)
+		return
+	}
+	tflog.Debug(ctx, "Retrieved issue types from API state")
+
+	newState.ID = types.StringValue(filter.id())
+	newState.IssueTypes = []jiraIssueTypesIssueTypeModel{}
+	for _, v := range issueTypes {
+		if !filter.match(v.ID, v.Name) {
+			continue
+		}
+		newState.IssueTypes = append(newState.IssueTypes, jiraIssueTypesIssueTypeModel{
+			ID:             types.StringValue(v.ID),
+			Name:           types.StringValue(v.Name),
+			Description:    types.StringValue(v.Description),
+			HierarchyLevel: types.Int64Value(int64(v.HierarchyLevel)),
+			IconUrl:        types.StringValue(v.IconURL),
+			AvatarId:       types.Int64Value(int64(v.AvatarID)),
+		})
+	}
+
+	tflog.Debug(ctx, "Storing issue types into the state", map[string]interface{}{
+		"readNewState": fmt.Sprintf("%+v", newState),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+}
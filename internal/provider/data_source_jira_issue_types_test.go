@@ -0,0 +1,63 @@
+package atlassian
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccJiraIssueTypesDataSource_Basic(t *testing.T) {
+	randomName := acctest.RandomWithPrefix("tf-test-issue-types")
+	dataSourceName := "data.atlassian_jira_issue_types.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Filter by name
+			{
+				Config: testAccJiraIssueTypesDataSourceConfig_nameRegex(randomName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "issue_types.#", "1"),
+					resource.TestCheckResourceAttrPair("atlassian_jira_issue_type.test", "id", dataSourceName, "issue_types.0.id"),
+					resource.TestCheckResourceAttr(dataSourceName, "issue_types.0.name", randomName),
+				),
+			},
+			// Filter by ID
+			{
+				Config: testAccJiraIssueTypesDataSourceConfig_ids(randomName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "issue_types.#", "1"),
+					resource.TestCheckResourceAttrPair("atlassian_jira_issue_type.test", "id", dataSourceName, "issue_types.0.id"),
+					resource.TestCheckResourceAttr(dataSourceName, "issue_types.0.name", randomName),
+				),
+			},
+		},
+	})
+}
+
+func testAccJiraIssueTypesDataSourceConfig_nameRegex(name string) string {
+	return fmt.Sprintf(`
+	resource "atlassian_jira_issue_type" "test" {
+		name = %[1]q
+	}
+
+	data "atlassian_jira_issue_types" "test" {
+		name_regex = "^%[1]s$"
+		depends_on = [atlassian_jira_issue_type.test]
+	}
+	`, name)
+}
+
+func testAccJiraIssueTypesDataSourceConfig_ids(name string) string {
+	return fmt.Sprintf(`
+	resource "atlassian_jira_issue_type" "test" {
+		name = %[1]q
+	}
+
+	data "atlassian_jira_issue_types" "test" {
+		ids = [atlassian_jira_issue_type.test.id]
+	}
+	`, name)
+}
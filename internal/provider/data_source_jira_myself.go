@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 
-	jira "github.com/ctreminiom/go-atlassian/jira/v3"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -29,6 +28,8 @@ type (
 		Locale           types.String                      `tfsdk:"locale"`
 		Groups           []jiraMyselfGroupsModel           `tfsdk:"groups"`
 		ApplicationRoles []jiraMyselfApplicationRolesModel `tfsdk:"application_roles"`
+
+		Site types.String `tfsdk:"site"`
 	}
 
 	jiraMyselfAvatarUrlsModel struct {
@@ -202,6 +203,7 @@ func (*jiraMyselfDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 					},
 				},
 			},
+			"site": dataSourceSiteAttribute(),
 		},
 	}
 }
@@ -212,29 +214,32 @@ func (d *jiraMyselfDataSource) Configure(ctx context.Context, req datasource.Con
 		return
 	}
 
-	client, ok := req.ProviderData.(*jira.Client)
+	p, ok := req.ProviderData.(*atlassianProvider)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *jira.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *atlassianProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	d.p.jira = client
+	d.p = *p
 }
 
 func (d *jiraMyselfDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	tflog.Debug(ctx, "Reading myself data source")
 
+	var config jiraMyselfDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx = withSite(ctx, config.Site)
+
 	myself, res, err := d.p.jira.MySelf.Details(ctx, []string{"groups", "applicationRoles"})
 	if err != nil {
-		var resBody string
-		if res != nil {
-			resBody = res.Bytes.String()
-		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get myself, got error: %s\n%s", err, resBody))
+		resp.Diagnostics.Append(clientErrorDiagnostics("get myself", res, err)...)
 		return
 	}
 	tflog.Debug(ctx, "Retrieved myself from API state", map[string]interface{}{
@@ -259,6 +264,7 @@ func (d *jiraMyselfDataSource) Read(ctx context.Context, req datasource.ReadRequ
 		Locale:           types.StringValue(myself.Locale),
 		Groups:           []jiraMyselfGroupsModel{},
 		ApplicationRoles: []jiraMyselfApplicationRolesModel{},
+		Site:             config.Site,
 	}
 
 	// Get groups
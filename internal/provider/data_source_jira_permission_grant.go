@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"strconv"
 
-	jira "github.com/ctreminiom/go-atlassian/jira/v3"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -22,6 +21,8 @@ type (
 		PermissionSchemeID types.String                    `tfsdk:"permission_scheme_id"`
 		Holder             *jiraPermissionGrantHolderModel `tfsdk:"holder"`
 		Permission         types.String                    `tfsdk:"permission"`
+
+		Site types.String `tfsdk:"site"`
 	}
 )
 
@@ -67,6 +68,7 @@ func (*jiraPermissionGrantDataSource) Schema(_ context.Context, _ datasource.Sch
 				MarkdownDescription: "The permission to grant.",
 				Computed:            true,
 			},
+			"site": dataSourceSiteAttribute(),
 		},
 	}
 }
@@ -77,17 +79,17 @@ func (d *jiraPermissionGrantDataSource) Configure(ctx context.Context, req datas
 		return
 	}
 
-	client, ok := req.ProviderData.(*jira.Client)
+	p, ok := req.ProviderData.(*atlassianProvider)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *jira.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *atlassianProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	d.p.jira = client
+	d.p = *p
 }
 
 func (d *jiraPermissionGrantDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -98,6 +100,7 @@ func (d *jiraPermissionGrantDataSource) Read(ctx context.Context, req datasource
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	ctx = withSite(ctx, newState.Site)
 	tflog.Debug(ctx, "Loaded permission grant config", map[string]interface{}{
 		"readConfig": fmt.Sprintf("%+v", newState),
 	})
@@ -106,11 +109,7 @@ func (d *jiraPermissionGrantDataSource) Read(ctx context.Context, req datasource
 	schemeId, _ := strconv.Atoi(newState.PermissionSchemeID.ValueString())
 	permissionGrant, res, err := d.p.jira.Permission.Scheme.Grant.Get(ctx, schemeId, grantId, []string{"all"})
 	if err != nil {
-		var resBody string
-		if res != nil {
-			resBody = res.Bytes.String()
-		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get permission grant, got error: %s\n%s", err, resBody))
+		resp.Diagnostics.Append(clientErrorDiagnostics("get permission grant", res, err)...)
 		return
 	}
 	tflog.Debug(ctx, "Retrieved permission grant from API state", map[string]interface{}{
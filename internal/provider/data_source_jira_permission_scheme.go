@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"strconv"
 
-	jira "github.com/ctreminiom/go-atlassian/jira/v3"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -23,6 +22,8 @@ type (
 		Self        types.String `tfsdk:"self"`
 		Name        types.String `tfsdk:"name"`
 		Description types.String `tfsdk:"description"`
+
+		Site types.String `tfsdk:"site"`
 	}
 )
 
@@ -58,6 +59,7 @@ func (*jiraPermissionSchemeDataSource) Schema(_ context.Context, _ datasource.Sc
 				MarkdownDescription: "The description of the permission scheme.",
 				Computed:            true,
 			},
+			"site": dataSourceSiteAttribute(),
 		},
 	}
 }
@@ -68,17 +70,17 @@ func (d *jiraPermissionSchemeDataSource) Configure(ctx context.Context, req data
 		return
 	}
 
-	client, ok := req.ProviderData.(*jira.Client)
+	p, ok := req.ProviderData.(*atlassianProvider)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *jira.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *atlassianProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	d.p.jira = client
+	d.p = *p
 }
 
 func (d *jiraPermissionSchemeDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -89,6 +91,7 @@ func (d *jiraPermissionSchemeDataSource) Read(ctx context.Context, req datasourc
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	ctx = withSite(ctx, newState.Site)
 	tflog.Debug(ctx, "Loaded permission scheme config", map[string]interface{}{
 		"readConfig": fmt.Sprintf("%+v", newState),
 	})
@@ -101,11 +104,7 @@ func (d *jiraPermissionSchemeDataSource) Read(ctx context.Context, req datasourc
 
 	permissionScheme, res, err := d.p.jira.Permission.Scheme.Get(ctx, schemeId, []string{"all"})
 	if err != nil {
-		var resBody string
-		if res != nil {
-			resBody = res.Bytes.String()
-		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get permission scheme, got error: %s\n%s", err, resBody))
+		resp.Diagnostics.Append(clientErrorDiagnostics("get permission scheme", res, err)...)
 		return
 	}
 	tflog.Debug(ctx, "Retrieved permission scheme from API state", map[string]interface{}{
@@ -0,0 +1,150 @@
+package atlassian
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+type (
+	jiraPermissionSchemesDataSource struct {
+		p atlassianProvider
+	}
+
+	jiraPermissionSchemesDataSourceModel struct {
+		ID                types.String                                 `tfsdk:"id"`
+		IDs               types.List                                   `tfsdk:"ids"`
+		NameRegex         types.String                                 `tfsdk:"name_regex"`
+		PermissionSchemes []jiraPermissionSchemesPermissionSchemeModel `tfsdk:"permission_schemes"`
+
+		Site types.String `tfsdk:"site"`
+	}
+
+	jiraPermissionSchemesPermissionSchemeModel struct {
+		ID          types.String `tfsdk:"id"`
+		Name        types.String `tfsdk:"name"`
+		Description types.String `tfsdk:"description"`
+		Self        types.String `tfsdk:"self"`
+	}
+)
+
+var (
+	_ datasource.DataSource = (*jiraPermissionSchemesDataSource)(nil)
+)
+
+func NewJiraPermissionSchemesDataSource() datasource.DataSource {
+	return &jiraPermissionSchemesDataSource{}
+}
+
+func (*jiraPermissionSchemesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_jira_permission_schemes"
+}
+
+func (*jiraPermissionSchemesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Jira Permission Schemes Data Source",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the data source.",
+				Computed:            true,
+			},
+			"ids":        jiraListIDsAttribute("permission schemes"),
+			"name_regex": jiraListNameRegexAttribute("permission schemes"),
+			"permission_schemes": schema.ListNestedAttribute{
+				MarkdownDescription: "The list of permission schemes.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the permission scheme.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the permission scheme.",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "The description of the permission scheme.",
+							Computed:            true,
+						},
+						"self": schema.StringAttribute{
+							MarkdownDescription: "The URL of the permission scheme.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"site": dataSourceSiteAttribute(),
+		},
+	}
+}
+
+func (d *jiraPermissionSchemesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	p, ok := req.ProviderData.(*atlassianProvider)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *atlassianProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.p = *p
+}
+
+func (d *jiraPermissionSchemesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Debug(ctx, "Reading permission schemes data source")
+
+	var newState jiraPermissionSchemesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &newState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx = withSite(ctx, newState.Site)
+	tflog.Debug(ctx, "Loaded permission schemes config", map[string]interface{}{
+		"readConfig": fmt.Sprintf("%+v", newState),
+	})
+
+	filter, diags := newJiraListFilter(ctx, newState.IDs, newState.NameRegex)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	permissionSchemes, res, err := d.p.jira.Permission.Scheme.Gets(ctx)
+	if err != nil {
+		resp.Diagnostics.Append(clientErrorDiagnostics("get permission schemes", res, err)...)
+		return
+	}
+	tflog.Debug(ctx, "Retrieved permission schemes from API state")
+
+	newState.ID = types.StringValue(filter.id())
+	newState.PermissionSchemes = []jiraPermissionSchemesPermissionSchemeModel{}
+	for _, v := range permissionSchemes.PermissionSchemes {
+		if !filter.match(strconv.Itoa(v.ID), v.Name) {
+			continue
+		}
+		newState.PermissionSchemes = append(newState.PermissionSchemes, jiraPermissionSchemesPermissionSchemeModel{
+			ID:          types.StringValue(strconv.Itoa(v.ID)),
+			Name:        types.StringValue(v.Name),
+			Description: types.StringValue(v.Description),
+			Self:        types.StringValue(v.Self),
+		})
+	}
+
+	tflog.Debug(ctx, "Storing permission schemes into the state", map[string]interface{}{
+		"readNewState": fmt.Sprintf("%+v", newState),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+}
@@ -0,0 +1,63 @@
+package atlassian
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccJiraPermissionSchemesDataSource_Basic(t *testing.T) {
+	randomName := acctest.RandomWithPrefix("tf-test-permission-schemes")
+	dataSourceName := "data.atlassian_jira_permission_schemes.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Filter by name
+			{
+				Config: testAccJiraPermissionSchemesDataSourceConfig_nameRegex(randomName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "permission_schemes.#", "1"),
+					resource.TestCheckResourceAttrPair("atlassian_jira_permission_scheme.test", "id", dataSourceName, "permission_schemes.0.id"),
+					resource.TestCheckResourceAttr(dataSourceName, "permission_schemes.0.name", randomName),
+				),
+			},
+			// Filter by ID
+			{
+				Config: testAccJiraPermissionSchemesDataSourceConfig_ids(randomName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "permission_schemes.#", "1"),
+					resource.TestCheckResourceAttrPair("atlassian_jira_permission_scheme.test", "id", dataSourceName, "permission_schemes.0.id"),
+					resource.TestCheckResourceAttr(dataSourceName, "permission_schemes.0.name", randomName),
+				),
+			},
+		},
+	})
+}
+
+func testAccJiraPermissionSchemesDataSourceConfig_nameRegex(name string) string {
+	return fmt.Sprintf(`
+	resource "atlassian_jira_permission_scheme" "test" {
+		name = %[1]q
+	}
+
+	data "atlassian_jira_permission_schemes" "test" {
+		name_regex = "^%[1]s$"
+		depends_on = [atlassian_jira_permission_scheme.test]
+	}
+	`, name)
+}
+
+func testAccJiraPermissionSchemesDataSourceConfig_ids(name string) string {
+	return fmt.Sprintf(`
+	resource "atlassian_jira_permission_scheme" "test" {
+		name = %[1]q
+	}
+
+	data "atlassian_jira_permission_schemes" "test" {
+		ids = [atlassian_jira_permission_scheme.test.id]
+	}
+	`, name)
+}
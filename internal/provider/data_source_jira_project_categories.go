@@ -0,0 +1,149 @@
+package atlassian
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+type (
+	jiraProjectCategoriesDataSource struct {
+		p atlassianProvider
+	}
+
+	jiraProjectCategoriesDataSourceModel struct {
+		ID                types.String                                `tfsdk:"id"`
+		IDs               types.List                                  `tfsdk:"ids"`
+		NameRegex         types.String                                `tfsdk:"name_regex"`
+		ProjectCategories []jiraProjectCategoriesProjectCategoryModel `tfsdk:"project_categories"`
+
+		Site types.String `tfsdk:"site"`
+	}
+
+	jiraProjectCategoriesProjectCategoryModel struct {
+		ID          types.String `tfsdk:"id"`
+		Name        types.String `tfsdk:"name"`
+		Description types.String `tfsdk:"description"`
+		Self        types.String `tfsdk:"self"`
+	}
+)
+
+var (
+	_ datasource.DataSource = (*jiraProjectCategoriesDataSource)(nil)
+)
+
+func NewJiraProjectCategoriesDataSource() datasource.DataSource {
+	return &jiraProjectCategoriesDataSource{}
+}
+
+func (*jiraProjectCategoriesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_jira_project_categories"
+}
+
+func (*jiraProjectCategoriesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Jira Project Categories Data Source",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the data source.",
+				Computed:            true,
+			},
+			"ids":        jiraListIDsAttribute("project categories"),
+			"name_regex": jiraListNameRegexAttribute("project categories"),
+			"project_categories": schema.ListNestedAttribute{
+				MarkdownDescription: "The list of project categories.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the project category.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the project category.",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "The description of the project category.",
+							Computed:            true,
+						},
+						"self": schema.StringAttribute{
+							MarkdownDescription: "The URL of the project category.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"site": dataSourceSiteAttribute(),
+		},
+	}
+}
+
+func (d *jiraProjectCategoriesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	p, ok := req.ProviderData.(*atlassianProvider)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *atlassianProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.p = *p
+}
+
+func (d *jiraProjectCategoriesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Debug(ctx, "Reading project categories data source")
+
+	var newState jiraProjectCategoriesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &newState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx = withSite(ctx, newState.Site)
+	tflog.Debug(ctx, "Loaded project categories config", map[string]interface{}{
+		"readConfig": fmt.Sprintf("%+v", newState),
+	})
+
+	filter, diags := newJiraListFilter(ctx, newState.IDs, newState.NameRegex)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectCategories, res, err := d.p.jira.Project.Category.Gets(ctx)
+	if err != nil {
+		resp.Diagnostics.Append(clientErrorDiagnostics("get project categories", res, err)...)
+		return
+	}
+	tflog.Debug(ctx, "Retrieved project categories from API state")
+
+	newState.ID = types.StringValue(filter.id())
+	newState.ProjectCategories = []jiraProjectCategoriesProjectCategoryModel{}
+	for _, v := range projectCategories {
+		if !filter.match(v.ID, v.Name) {
+			continue
+		}
+		newState.ProjectCategories = append(newState.ProjectCategories, jiraProjectCategoriesProjectCategoryModel{
+			ID:          types.StringValue(v.ID),
+			Name:        types.StringValue(v.Name),
+			Description: types.StringValue(v.Description),
+			Self:        types.StringValue(v.Self),
+		})
+	}
+
+	tflog.Debug(ctx, "Storing project categories into the state", map[string]interface{}{
+		"readNewState": fmt.Sprintf("%+v", newState),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+}
@@ -0,0 +1,63 @@
+package atlassian
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccJiraProjectCategoriesDataSource_Basic(t *testing.T) {
+	randomName := acctest.RandomWithPrefix("tf-test-project-categories")
+	dataSourceName := "data.atlassian_jira_project_categories.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Filter by name
+			{
+				Config: testAccJiraProjectCategoriesDataSourceConfig_nameRegex(randomName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "project_categories.#", "1"),
+					resource.TestCheckResourceAttrPair("atlassian_jira_project_category.test", "id", dataSourceName, "project_categories.0.id"),
+					resource.TestCheckResourceAttr(dataSourceName, "project_categories.0.name", randomName),
+				),
+			},
+			// Filter by ID
+			{
+				Config: testAccJiraProjectCategoriesDataSourceConfig_ids(randomName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "project_categories.#", "1"),
+					resource.TestCheckResourceAttrPair("atlassian_jira_project_category.test", "id", dataSourceName, "project_categories.0.id"),
+					resource.TestCheckResourceAttr(dataSourceName, "project_categories.0.name", randomName),
+				),
+			},
+		},
+	})
+}
+
+func testAccJiraProjectCategoriesDataSourceConfig_nameRegex(name string) string {
+	return fmt.Sprintf(`
+	resource "atlassian_jira_project_category" "test" {
+		name = %[1]q
+	}
+
+	data "atlassian_jira_project_categories" "test" {
+		name_regex = "^%[1]s$"
+		depends_on = [atlassian_jira_project_category.test]
+	}
+	`, name)
+}
+
+func testAccJiraProjectCategoriesDataSourceConfig_ids(name string) string {
+	return fmt.Sprintf(`
+	resource "atlassian_jira_project_category" "test" {
+		name = %[1]q
+	}
+
+	data "atlassian_jira_project_categories" "test" {
+		ids = [atlassian_jira_project_category.test.id]
+	}
+	`, name)
+}
@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"strconv"
 
-	jira "github.com/ctreminiom/go-atlassian/jira/v3"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -23,6 +22,8 @@ type (
 		Name        types.String `tfsdk:"name"`
 		Description types.String `tfsdk:"description"`
 		Self        types.String `tfsdk:"self"`
+
+		Site types.String `tfsdk:"site"`
 	}
 )
 
@@ -58,6 +59,7 @@ func (*jiraProjectCategoryDataSource) Schema(_ context.Context, _ datasource.Sch
 				MarkdownDescription: "The URL of the project category.",
 				Computed:            true,
 			},
+			"site": dataSourceSiteAttribute(),
 		},
 	}
 }
@@ -68,17 +70,17 @@ func (d *jiraProjectCategoryDataSource) Configure(ctx context.Context, req datas
 		return
 	}
 
-	client, ok := req.ProviderData.(*jira.Client)
+	p, ok := req.ProviderData.(*atlassianProvider)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *jira.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *atlassianProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	d.p.jira = client
+	d.p = *p
 }
 
 func (d *jiraProjectCategoryDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -89,6 +91,7 @@ func (d *jiraProjectCategoryDataSource) Read(ctx context.Context, req datasource
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	ctx = withSite(ctx, newState.Site)
 	tflog.Debug(ctx, "Loaded project category config", map[string]interface{}{
 		"readConfig": fmt.Sprintf("%+v", newState),
 	})
@@ -101,11 +104,7 @@ func (d *jiraProjectCategoryDataSource) Read(ctx context.Context, req datasource
 
 	projectCategory, res, err := d.p.jira.Project.Category.Get(ctx, projectCategoryId)
 	if err != nil {
-		var resBody string
-		if res != nil {
-			resBody = res.Bytes.String()
-		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get project category, got error: %s\n%s", err, resBody))
+		resp.Diagnostics.Append(clientErrorDiagnostics("get project category", res, err)...)
 		return
 	}
 	tflog.Debug(ctx, "Retrieved project category from API state", map[string]interface{}{
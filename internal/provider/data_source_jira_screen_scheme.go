@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"strconv"
 
-	jira "github.com/ctreminiom/go-atlassian/jira/v3"
 	"github.com/ctreminiom/go-atlassian/pkg/infra/models"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -24,6 +23,8 @@ type (
 		Name        types.String                `tfsdk:"name"`
 		Description types.String                `tfsdk:"description"`
 		Screens     *jiraScreenSchemeTypesModel `tfsdk:"screens"`
+
+		Site types.String `tfsdk:"site"`
 	}
 )
 
@@ -81,6 +82,7 @@ func (d *jiraScreenSchemeDataSource) Schema(_ context.Context, _ datasource.Sche
 					},
 				},
 			},
+			"site": dataSourceSiteAttribute(),
 		},
 	}
 }
@@ -91,17 +93,17 @@ func (d *jiraScreenSchemeDataSource) Configure(ctx context.Context, req datasour
 		return
 	}
 
-	client, ok := req.ProviderData.(*jira.Client)
+	p, ok := req.ProviderData.(*atlassianProvider)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *jira.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *atlassianProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	d.p.jira = client
+	d.p = *p
 }
 
 func (d *jiraScreenSchemeDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -112,6 +114,7 @@ func (d *jiraScreenSchemeDataSource) Read(ctx context.Context, req datasource.Re
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	ctx = withSite(ctx, newState.Site)
 	tflog.Debug(ctx, "Loaded screen scheme config", map[string]interface{}{
 		"readConfig": fmt.Sprintf("%+v", newState),
 	})
@@ -127,11 +130,7 @@ func (d *jiraScreenSchemeDataSource) Read(ctx context.Context, req datasource.Re
 	}
 	screenScheme, res, err := d.p.jira.Screen.Scheme.Gets(ctx, options, 0, 1)
 	if err != nil {
-		var resBody string
-		if res != nil {
-			resBody = res.Bytes.String()
-		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get screen scheme, got error: %s\n%s", err, resBody))
+		resp.Diagnostics.Append(clientErrorDiagnostics("get screen scheme", res, err)...)
 	}
 	tflog.Debug(ctx, "Retrieved screen scheme from API state", map[string]interface{}{
 		"readApiState": fmt.Sprintf("%+v", screenScheme.Values[0]),
@@ -0,0 +1,196 @@
+package atlassian
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+type (
+	jiraScreenSchemesDataSource struct {
+		p atlassianProvider
+	}
+
+	jiraScreenSchemesDataSourceModel struct {
+		ID            types.String                         `tfsdk:"id"`
+		IDs           types.List                           `tfsdk:"ids"`
+		NameRegex     types.String                         `tfsdk:"name_regex"`
+		ScreenSchemes []jiraScreenSchemesScreenSchemeModel `tfsdk:"screen_schemes"`
+
+		Site types.String `tfsdk:"site"`
+	}
+
+	jiraScreenSchemesScreenSchemeModel struct {
+		ID          types.String                `tfsdk:"id"`
+		Name        types.String                `tfsdk:"name"`
+		Description types.String                `tfsdk:"description"`
+		Screens     *jiraScreenSchemeTypesModel `tfsdk:"screens"`
+	}
+)
+
+var (
+	_ datasource.DataSource = (*jiraScreenSchemesDataSource)(nil)
+)
+
+func NewJiraScreenSchemesDataSource() datasource.DataSource {
+	return &jiraScreenSchemesDataSource{}
+}
+
+func (*jiraScreenSchemesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_jira_screen_schemes"
+}
+
+func (*jiraScreenSchemesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Jira Screen Schemes Data Source",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the data source.",
+				Computed:            true,
+			},
+			"ids":        jiraListIDsAttribute("screen schemes"),
+			"name_regex": jiraListNameRegexAttribute("screen schemes"),
+			"screen_schemes": schema.ListNestedAttribute{
+				MarkdownDescription: "The list of screen schemes.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the screen scheme.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the screen scheme.",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "The description of the screen scheme.",
+							Computed:            true,
+						},
+						"screens": schema.SingleNestedAttribute{
+							MarkdownDescription: "The IDs of the screens for the screen types of the screen scheme.",
+							Computed:            true,
+							Attributes: map[string]schema.Attribute{
+								"create": schema.Int64Attribute{
+									MarkdownDescription: "The ID of the create screen.",
+									Computed:            true,
+								},
+								"default": schema.Int64Attribute{
+									MarkdownDescription: "The ID of the default screen.",
+									Computed:            true,
+								},
+								"view": schema.Int64Attribute{
+									MarkdownDescription: "The ID of the view screen.",
+									Computed:            true,
+								},
+								"edit": schema.Int64Attribute{
+									MarkdownDescription: "The ID of the edit screen.",
+									Computed:            true,
+								},
+							},
+						},
+					},
+				},
+			},
+			"site": dataSourceSiteAttribute(),
+		},
+	}
+}
+
+func (d *jiraScreenSchemesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	p, ok := req.ProviderData.(*atlassianProvider)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *atlassianProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.p = *p
+}
+
+func (d *jiraScreenSchemesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Debug(ctx, "Reading screen schemes data source")
+
+	var newState jiraScreenSchemesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &newState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx = withSite(ctx, newState.Site)
+	tflog.Debug(ctx, "Loaded screen schemes config", map[string]interface{}{
+		"readConfig": fmt.Sprintf("%+v", newState),
+	})
+
+	filter, diags := newJiraListFilter(ctx, newState.IDs, newState.NameRegex)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ids, diags := filter.intIDs()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	options := &models.ScreenSchemeParamsScheme{
+		IDs: ids,
+	}
+
+	newState.ID = types.StringValue(filter.id())
+	newState.ScreenSchemes = []jiraScreenSchemesScreenSchemeModel{}
+	isLast := false
+	startAt := 0
+	for !isLast {
+		page, res, err := d.p.jira.Screen.Scheme.Gets(ctx, options, startAt, jiraListPageSize)
+		if err != nil {
+			resp.Diagnostics.Append(clientErrorDiagnostics("get screen schemes", res, err)...)
+			return
+		}
+		startAt += jiraListPageSize
+		isLast = page.IsLast || len(page.Values) == 0
+		for _, v := range page.Values {
+			if !filter.match(strconv.Itoa(v.ID), v.Name) {
+				continue
+			}
+			newState.ScreenSchemes = append(newState.ScreenSchemes, jiraScreenSchemesScreenSchemeModel{
+				ID:          types.StringValue(strconv.Itoa(v.ID)),
+				Name:        types.StringValue(v.Name),
+				Description: types.StringValue(v.Description),
+				Screens:     newJiraScreenSchemeTypesModel(v.Screens),
+			})
+		}
+	}
+	tflog.Debug(ctx, "Retrieved screen schemes from API state")
+
+	tflog.Debug(ctx, "Storing screen schemes into the state", map[string]interface{}{
+		"readNewState": fmt.Sprintf("%+v", newState),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+}
+
+func newJiraScreenSchemeTypesModel(screens *models.ScreenTypesScheme) *jiraScreenSchemeTypesModel {
+	if screens == nil {
+		return nil
+	}
+	return &jiraScreenSchemeTypesModel{
+		Create:  types.Int64Value(int64(screens.Create)),
+		Default: types.Int64Value(int64(screens.Default)),
+		View:    types.Int64Value(int64(screens.View)),
+		Edit:    types.Int64Value(int64(screens.Edit)),
+	}
+}
@@ -0,0 +1,69 @@
+package atlassian
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccJiraScreenSchemesDataSource_Basic(t *testing.T) {
+	randomName := acctest.RandomWithPrefix("tf-test-screen-schemes")
+	dataSourceName := "data.atlassian_jira_screen_schemes.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Filter by name
+			{
+				Config: testAccJiraScreenSchemesDataSourceConfig_nameRegex(randomName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "screen_schemes.#", "1"),
+					resource.TestCheckResourceAttrPair("atlassian_jira_screen_scheme.test", "id", dataSourceName, "screen_schemes.0.id"),
+					resource.TestCheckResourceAttr(dataSourceName, "screen_schemes.0.name", randomName),
+				),
+			},
+			// Filter by ID
+			{
+				Config: testAccJiraScreenSchemesDataSourceConfig_ids(randomName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "screen_schemes.#", "1"),
+					resource.TestCheckResourceAttrPair("atlassian_jira_screen_scheme.test", "id", dataSourceName, "screen_schemes.0.id"),
+					resource.TestCheckResourceAttr(dataSourceName, "screen_schemes.0.name", randomName),
+				),
+			},
+		},
+	})
+}
+
+func testAccJiraScreenSchemesDataSourceConfig_nameRegex(name string) string {
+	return fmt.Sprintf(`
+	resource "atlassian_jira_screen_scheme" "test" {
+		name = %[1]q
+		screens = {
+			default = 1
+		}
+	}
+
+	data "atlassian_jira_screen_schemes" "test" {
+		name_regex = "^%[1]s$"
+		depends_on = [atlassian_jira_screen_scheme.test]
+	}
+	`, name)
+}
+
+func testAccJiraScreenSchemesDataSourceConfig_ids(name string) string {
+	return fmt.Sprintf(`
+	resource "atlassian_jira_screen_scheme" "test" {
+		name = %[1]q
+		screens = {
+			default = 1
+		}
+	}
+
+	data "atlassian_jira_screen_schemes" "test" {
+		ids = [atlassian_jira_screen_scheme.test.id]
+	}
+	`, name)
+}
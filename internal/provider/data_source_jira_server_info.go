@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 
-	jira "github.com/ctreminiom/go-atlassian/jira/v3"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -27,6 +26,8 @@ type (
 		ServerTime     types.String `tfsdk:"server_time"`
 		ScmInfo        types.String `tfsdk:"scm_info"`
 		ServerTitle    types.String `tfsdk:"server_title"`
+
+		Site types.String `tfsdk:"site"`
 	}
 )
 
@@ -87,6 +88,7 @@ func (*jiraServerInfoDataSource) Schema(_ context.Context, _ datasource.SchemaRe
 				MarkdownDescription: "The name of the Jira instance.",
 				Computed:            true,
 			},
+			"site": dataSourceSiteAttribute(),
 		},
 	}
 }
@@ -97,29 +99,32 @@ func (d *jiraServerInfoDataSource) Configure(ctx context.Context, req datasource
 		return
 	}
 
-	client, ok := req.ProviderData.(*jira.Client)
+	p, ok := req.ProviderData.(*atlassianProvider)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *jira.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *atlassianProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	d.p.jira = client
+	d.p = *p
 }
 
 func (d *jiraServerInfoDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	tflog.Debug(ctx, "Reading server info data source")
 
+	var config jiraServerInfoDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx = withSite(ctx, config.Site)
+
 	serverInfo, res, err := d.p.jira.Server.Info(ctx)
 	if err != nil {
-		var resBody string
-		if res != nil {
-			resBody = res.Bytes.String()
-		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get server info, got error: %s\n%s", err, resBody))
+		resp.Diagnostics.Append(clientErrorDiagnostics("get server info", res, err)...)
 		return
 	}
 	tflog.Debug(ctx, "Retrieved server info from API state", map[string]interface{}{
@@ -137,6 +142,7 @@ func (d *jiraServerInfoDataSource) Read(ctx context.Context, req datasource.Read
 		ServerTime:     types.StringValue(serverInfo.ServerTime),
 		ScmInfo:        types.StringValue(serverInfo.ScmInfo),
 		ServerTitle:    types.StringValue(serverInfo.ServerTitle),
+		Site:           config.Site,
 	}
 	newState.VersionNumbers, _ = types.ListValueFrom(ctx, types.Int64Type, serverInfo.VersionNumbers)
 
@@ -0,0 +1,185 @@
+package atlassian
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+type (
+	jiraStatusesDataSource struct {
+		p atlassianProvider
+	}
+
+	jiraStatusesDataSourceModel struct {
+		ID        types.String              `tfsdk:"id"`
+		IDs       types.List                `tfsdk:"ids"`
+		NameRegex types.String              `tfsdk:"name_regex"`
+		Statuses  []jiraStatusesStatusModel `tfsdk:"statuses"`
+
+		Site types.String `tfsdk:"site"`
+	}
+
+	jiraStatusesStatusModel struct {
+		ID             types.String          `tfsdk:"id"`
+		Name           types.String          `tfsdk:"name"`
+		Description    types.String          `tfsdk:"description"`
+		StatusCategory types.String          `tfsdk:"status_category"`
+		StatusScope    *jiraStatusScopeModel `tfsdk:"status_scope"`
+	}
+)
+
+var (
+	_ datasource.DataSource = (*jiraStatusesDataSource)(nil)
+)
+
+func NewJiraStatusesDataSource() datasource.DataSource {
+	return &jiraStatusesDataSource{}
+}
+
+func (*jiraStatusesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_jira_statuses"
+}
+
+func (*jiraStatusesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Jira Statuses Data Source",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the data source.",
+				Computed:            true,
+			},
+			"ids":        jiraListIDsAttribute("statuses"),
+			"name_regex": jiraListNameRegexAttribute("statuses"),
+			"statuses": schema.ListNestedAttribute{
+				MarkdownDescription: "The list of statuses.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the status.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the status.",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "The description of the status.",
+							Computed:            true,
+						},
+						"status_category": schema.StringAttribute{
+							MarkdownDescription: "The category of the status.",
+							Computed:            true,
+						},
+						"status_scope": schema.SingleNestedAttribute{
+							MarkdownDescription: "The scope of the status.",
+							Computed:            true,
+							Attributes: map[string]schema.Attribute{
+								"type": schema.StringAttribute{
+									MarkdownDescription: "The scope of the status. `GLOBAL` for company-managed projects and `PROJECT` for team-managed projects.",
+									Computed:            true,
+								},
+								"id": schema.StringAttribute{
+									MarkdownDescription: "The ID of the team-managed project of the status, if the scope is `PROJECT`.",
+									Computed:            true,
+								},
+							},
+						},
+					},
+				},
+			},
+			"site": dataSourceSiteAttribute(),
+		},
+	}
+}
+
+func (d *jiraStatusesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	p, ok := req.ProviderData.(*atlassianProvider)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *atlassianProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.p = *p
+}
+
+func (d *jiraStatusesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Debug(ctx, "Reading statuses data source")
+
+	var newState jiraStatusesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &newState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx = withSite(ctx, newState.Site)
+	tflog.Debug(ctx, "Loaded statuses config", map[string]interface{}{
+		"readConfig": fmt.Sprintf("%+v", newState),
+	})
+
+	filter, diags := newJiraListFilter(ctx, newState.IDs, newState.NameRegex)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	newState.ID = types.StringValue(filter.id())
+	newState.Statuses = []jiraStatusesStatusModel{}
+	isLast := false
+	startAt := 0
+	for !isLast {
+		page, res, err := d.p.jira.Workflow.Status.Search(ctx, &models.WorkflowStatusSearchParams{}, startAt, jiraListPageSize)
+		if err != nil {
+			resp.Diagnostics.Append(clientErrorDiagnostics("get statuses", res, err)...)
+			return
+		}
+		startAt += jiraListPageSize
+		isLast = page.IsLast || len(page.Values) == 0
+		for _, v := range page.Values {
+			if !filter.match(v.ID, v.Name) {
+				continue
+			}
+			newState.Statuses = append(newState.Statuses, jiraStatusesStatusModel{
+				ID:             types.StringValue(v.ID),
+				Name:           types.StringValue(v.Name),
+				Description:    types.StringValue(v.Description),
+				StatusCategory: types.StringValue(v.StatusCategory),
+				StatusScope:    newJiraStatusScopeModel(v.Scope),
+			})
+		}
+	}
+	tflog.Debug(ctx, "Retrieved statuses from API state")
+
+	tflog.Debug(ctx, "Storing statuses into the state", map[string]interface{}{
+		"readNewState": fmt.Sprintf("%+v", newState),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+}
+
+func newJiraStatusScopeModel(scope *models.WorkflowStatusScopeScheme) *jiraStatusScopeModel {
+	if scope == nil {
+		return nil
+	}
+	m := &jiraStatusScopeModel{
+		Type: types.StringValue(scope.Type),
+		Id:   types.StringValue(""),
+	}
+	if scope.Project != nil {
+		m.Id = types.StringValue(scope.Project.ID)
+	}
+	return m
+}
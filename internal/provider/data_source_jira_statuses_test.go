@@ -0,0 +1,71 @@
+package atlassian
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccJiraStatusesDataSource_Basic(t *testing.T) {
+	randomName := acctest.RandomWithPrefix("tf-test-statuses")
+	dataSourceName := "data.atlassian_jira_statuses.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Filter by name
+			{
+				Config: testAccJiraStatusesDataSourceConfig_nameRegex(randomName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "statuses.#", "1"),
+					resource.TestCheckResourceAttrPair("atlassian_jira_status.test", "id", dataSourceName, "statuses.0.id"),
+					resource.TestCheckResourceAttr(dataSourceName, "statuses.0.name", randomName),
+				),
+			},
+			// Filter by ID
+			{
+				Config: testAccJiraStatusesDataSourceConfig_ids(randomName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "statuses.#", "1"),
+					resource.TestCheckResourceAttrPair("atlassian_jira_status.test", "id", dataSourceName, "statuses.0.id"),
+					resource.TestCheckResourceAttr(dataSourceName, "statuses.0.name", randomName),
+				),
+			},
+		},
+	})
+}
+
+func testAccJiraStatusesDataSourceConfig_nameRegex(name string) string {
+	return fmt.Sprintf(`
+	resource "atlassian_jira_status" "test" {
+		name = %[1]q
+		status_category = "TODO"
+		status_scope = {
+			type = "GLOBAL"
+		}
+	}
+
+	data "atlassian_jira_statuses" "test" {
+		name_regex = "^%[1]s$"
+		depends_on = [atlassian_jira_status.test]
+	}
+	`, name)
+}
+
+func testAccJiraStatusesDataSourceConfig_ids(name string) string {
+	return fmt.Sprintf(`
+	resource "atlassian_jira_status" "test" {
+		name = %[1]q
+		status_category = "TODO"
+		status_scope = {
+			type = "GLOBAL"
+		}
+	}
+
+	data "atlassian_jira_statuses" "test" {
+		ids = [atlassian_jira_status.test.id]
+	}
+	`, name)
+}
@@ -0,0 +1,167 @@
+package atlassian
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	common "github.com/openscientia/terraform-provider-atlassian/internal/provider/models"
+)
+
+type (
+	jiraSystemAvatarsDataSource struct {
+		p atlassianProvider
+	}
+
+	jiraSystemAvatarsDataSourceModel struct {
+		ID      types.String                   `tfsdk:"id"`
+		Type    types.String                   `tfsdk:"type"`
+		Avatars []jiraSystemAvatarsAvatarModel `tfsdk:"avatars"`
+
+		Site types.String `tfsdk:"site"`
+	}
+
+	jiraSystemAvatarsAvatarModel struct {
+		ID         types.String            `tfsdk:"id"`
+		FileName   types.String            `tfsdk:"file_name"`
+		AvatarUrls *common.AvatarUrlsModel `tfsdk:"avatar_urls"`
+	}
+)
+
+var (
+	_ datasource.DataSource = (*jiraSystemAvatarsDataSource)(nil)
+)
+
+func NewJiraSystemAvatarsDataSource() datasource.DataSource {
+	return &jiraSystemAvatarsDataSource{}
+}
+
+func (*jiraSystemAvatarsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_jira_system_avatars"
+}
+
+func (*jiraSystemAvatarsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Jira System Avatars Data Source",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the system avatars. Defaults to value of `type`.",
+				Computed:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "The avatar type. Valid values: `issuetype`, `project` and `priority`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(jiraAvatarTypes...),
+				},
+			},
+			"avatars": schema.ListNestedAttribute{
+				MarkdownDescription: "The list of system avatars.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the avatar.",
+							Computed:            true,
+						},
+						"file_name": schema.StringAttribute{
+							MarkdownDescription: "The file name of the avatar.",
+							Computed:            true,
+						},
+						"avatar_urls": schema.SingleNestedAttribute{
+							MarkdownDescription: "The URLs of the avatar.",
+							Computed:            true,
+							Attributes: map[string]schema.Attribute{
+								"p16x16": schema.StringAttribute{
+									MarkdownDescription: "The URL of the item's 16x16 pixel avatar.",
+									Computed:            true,
+								},
+								"p24x24": schema.StringAttribute{
+									MarkdownDescription: "The URL of the item's 24x24 pixel avatar.",
+									Computed:            true,
+								},
+								"p32x32": schema.StringAttribute{
+									MarkdownDescription: "The URL of the item's 32x32 pixel avatar.",
+									Computed:            true,
+								},
+								"p48x48": schema.StringAttribute{
+									MarkdownDescription: "The URL of the item's 48x48 pixel avatar.",
+									Computed:            true,
+								},
+							},
+						},
+					},
+				},
+			},
+			"site": dataSourceSiteAttribute(),
+		},
+	}
+}
+
+func (d *jiraSystemAvatarsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	p, ok := req.ProviderData.(*atlassianProvider)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *atlassianProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.p = *p
+}
+
+func (d *jiraSystemAvatarsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Debug(ctx, "Reading system avatars data source")
+
+	var newState jiraSystemAvatarsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &newState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx = withSite(ctx, newState.Site)
+	tflog.Debug(ctx, "Loaded system avatars config", map[string]interface{}{
+		"readConfig": fmt.Sprintf("%+v", newState),
+	})
+
+	avatars, res, err := getJiraSystemAvatars(ctx, d.p.jira, newState.Type.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(clientErrorDiagnostics("get system avatars", res, err)...)
+		return
+	}
+	tflog.Debug(ctx, "Retrieved system avatars from API state")
+
+	newState.ID = types.StringValue(newState.Type.ValueString())
+	newState.Avatars = []jiraSystemAvatarsAvatarModel{}
+	for _, a := range avatars {
+		avatar := jiraSystemAvatarsAvatarModel{
+			ID:       types.StringValue(a.ID),
+			FileName: types.StringValue(a.FileName),
+		}
+		if a.Urls != nil {
+			avatar.AvatarUrls = &common.AvatarUrlsModel{
+				One6X16:   types.StringValue(a.Urls.One6X16),
+				Two4X24:   types.StringValue(a.Urls.Two4X24),
+				Three2X32: types.StringValue(a.Urls.Three2X32),
+				Four8X48:  types.StringValue(a.Urls.Four8X48),
+			}
+		}
+		newState.Avatars = append(newState.Avatars, avatar)
+	}
+
+	tflog.Debug(ctx, "Storing system avatars into the state", map[string]interface{}{
+		"readNewState": fmt.Sprintf("%+v", newState),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+}
@@ -0,0 +1,31 @@
+package atlassian
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccJiraSystemAvatarsDataSource_Basic(t *testing.T) {
+	dataSourceName := "data.atlassian_jira_system_avatars.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccJiraSystemAvatarsDataSourceConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "id", "issuetype"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "avatars.0.id"),
+				),
+			},
+		},
+	})
+}
+
+const testAccJiraSystemAvatarsDataSourceConfig_basic = `
+data "atlassian_jira_system_avatars" "test" {
+  type = "issuetype"
+}
+`
@@ -0,0 +1,122 @@
+package atlassian
+
+import (
+	"context"
+	"fmt"
+	"hash/crc32"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/openscientia/terraform-provider-atlassian/internal/provider/validators"
+)
+
+// jiraListPageSize is the number of results requested per page by the plural data sources,
+// which page through every result.
+const jiraListPageSize = 50
+
+// jiraListFilter filters the results of the plural data sources by ID and name.
+type jiraListFilter struct {
+	ids       []string
+	nameRegex *regexp.Regexp
+}
+
+// newJiraListFilter returns the filter configured by the `ids` and `name_regex` attributes.
+func newJiraListFilter(ctx context.Context, ids types.List, nameRegex types.String) (*jiraListFilter, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	filter := &jiraListFilter{}
+
+	if !ids.IsNull() {
+		diags.Append(ids.ElementsAs(ctx, &filter.ids, false)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+	}
+
+	if !nameRegex.IsNull() {
+		re, err := regexp.Compile(nameRegex.ValueString())
+		if err != nil {
+			diags.AddAttributeError(path.Root("name_regex"), "Invalid Regular Expression",
+				fmt.Sprintf("Parsing regular expression %q failed: %v", nameRegex.ValueString(), err))
+			return nil, diags
+		}
+		filter.nameRegex = re
+	}
+
+	return filter, diags
+}
+
+// match reports whether a result with the given ID and name matches the filter.
+func (f *jiraListFilter) match(id, name string) bool {
+	if f.ids != nil {
+		found := false
+		for _, v := range f.ids {
+			if v == id {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return f.nameRegex == nil || f.nameRegex.MatchString(name)
+}
+
+// intIDs returns the IDs of the filter as integers, for the APIs which filter by ID.
+// It returns nil when the filter has no IDs.
+func (f *jiraListFilter) intIDs() ([]int, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if f.ids == nil {
+		return nil, diags
+	}
+
+	ids := make([]int, 0, len(f.ids))
+	for i, v := range f.ids {
+		id, err := strconv.Atoi(v)
+		if err != nil {
+			diags.AddAttributeError(path.Root("ids").AtListIndex(i), "Unable to parse value of \"ids\" attribute.",
+				"Values of \"ids\" attribute can only be numeric strings.")
+			continue
+		}
+		ids = append(ids, id)
+	}
+	return ids, diags
+}
+
+// id returns the ID of the data source, which identifies the filter.
+func (f *jiraListFilter) id() string {
+	ids := append([]string{}, f.ids...)
+	sort.Strings(ids)
+	nameRegex := ""
+	if f.nameRegex != nil {
+		nameRegex = f.nameRegex.String()
+	}
+	return strconv.FormatUint(uint64(crc32.ChecksumIEEE([]byte(strings.Join(ids, ",")+"|"+nameRegex))), 10)
+}
+
+// jiraListIDsAttribute returns the `ids` attribute of a plural data source.
+func jiraListIDsAttribute(objects string) schema.ListAttribute {
+	return schema.ListAttribute{
+		MarkdownDescription: fmt.Sprintf("The IDs of the %s to return. Defaults to all %s.", objects, objects),
+		Optional:            true,
+		ElementType:         types.StringType,
+	}
+}
+
+// jiraListNameRegexAttribute returns the `name_regex` attribute of a plural data source.
+func jiraListNameRegexAttribute(objects string) schema.StringAttribute {
+	return schema.StringAttribute{
+		MarkdownDescription: fmt.Sprintf("A regular expression matching the names of the %s to return.", objects),
+		Optional:            true,
+		Validators: []validator.String{
+			validators.ValidRegexp(),
+		},
+	}
+}
@@ -0,0 +1,164 @@
+package atlassian
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	jira "github.com/ctreminiom/go-atlassian/jira/v3"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestJiraListFilter(t *testing.T) {
+	ctx := context.Background()
+	ids := types.ListValueMust(types.StringType, []attr.Value{types.StringValue("10000"), types.StringValue("10001")})
+
+	tests := map[string]struct {
+		ids       types.List
+		nameRegex types.String
+		id        string
+		name      string
+		want      bool
+	}{
+		"no filter":       {ids: types.ListNull(types.StringType), nameRegex: types.StringNull(), id: "10002", name: "Bug", want: true},
+		"id match":        {ids: ids, nameRegex: types.StringNull(), id: "10001", name: "Bug", want: true},
+		"id mismatch":     {ids: ids, nameRegex: types.StringNull(), id: "10002", name: "Bug", want: false},
+		"name match":      {ids: types.ListNull(types.StringType), nameRegex: types.StringValue("^B"), id: "10002", name: "Bug", want: true},
+		"name mismatch":   {ids: types.ListNull(types.StringType), nameRegex: types.StringValue("^B"), id: "10002", name: "Task", want: false},
+		"both match":      {ids: ids, nameRegex: types.StringValue("^B"), id: "10000", name: "Bug", want: true},
+		"name mismatches": {ids: ids, nameRegex: types.StringValue("^B"), id: "10000", name: "Task", want: false},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			filter, diags := newJiraListFilter(ctx, tt.ids, tt.nameRegex)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if got := filter.match(tt.id, tt.name); got != tt.want {
+				t.Errorf("expected match %t, got: %t", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestJiraListFilter_Errors(t *testing.T) {
+	ctx := context.Background()
+
+	_, diags := newJiraListFilter(ctx, types.ListNull(types.StringType), types.StringValue("["))
+	if !diags.HasError() {
+		t.Fatal("expected invalid regular expression error")
+	}
+
+	filter, diags := newJiraListFilter(ctx, types.ListValueMust(types.StringType, []attr.Value{types.StringValue("foo")}), types.StringNull())
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	_, diags = filter.intIDs()
+	if !diags.HasError() {
+		t.Fatal("expected non-numeric ID error")
+	}
+	if got := diags[0].(interface{ Path() path.Path }).Path(); !got.Equal(path.Root("ids").AtListIndex(0)) {
+		t.Errorf("expected error on ids[0], got: %s", got)
+	}
+}
+
+func TestJiraListFilter_ID(t *testing.T) {
+	ctx := context.Background()
+	a, _ := newJiraListFilter(ctx, types.ListValueMust(types.StringType, []attr.Value{types.StringValue("1"), types.StringValue("2")}), types.StringNull())
+	b, _ := newJiraListFilter(ctx, types.ListValueMust(types.StringType, []attr.Value{types.StringValue("2"), types.StringValue("1")}), types.StringNull())
+	c, _ := newJiraListFilter(ctx, types.ListNull(types.StringType), types.StringValue("^B"))
+
+	if a.id() != b.id() {
+		t.Errorf("expected IDs to ignore the order of ids, got: %s and %s", a.id(), b.id())
+	}
+	if a.id() == c.id() {
+		t.Errorf("expected different filters to have different IDs, got: %s", a.id())
+	}
+}
+
+// TestJiraIssueScreensDataSource_Paging checks that every page of results is read, using a fake
+// Atlassian server.
+func TestJiraIssueScreensDataSource_Paging(t *testing.T) {
+	ctx := context.Background()
+	total := jiraListPageSize*2 + 3
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		startAt, _ := strconv.Atoi(r.URL.Query().Get("startAt"))
+		values := ""
+		for i := startAt; i < total && i < startAt+jiraListPageSize; i++ {
+			if values != "" {
+				values += ","
+			}
+			values += fmt.Sprintf(`{"id": %d, "name": "Screen %d"}`, i+1, i+1)
+		}
+		isLast := startAt+jiraListPageSize >= total
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"startAt": %d, "maxResults": %d, "total": %d, "isLast": %t, "values": [%s]}`, startAt, jiraListPageSize, total, isLast, values)
+	}))
+	defer srv.Close()
+
+	client, err := jira.New(srv.Client(), srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	d := NewJiraIssueScreensDataSource()
+	d.(datasource.DataSourceWithConfigure).Configure(ctx, datasource.ConfigureRequest{ProviderData: &atlassianProvider{jira: client}}, &datasource.ConfigureResponse{})
+
+	tests := map[string]struct {
+		nameRegex string
+		want      int
+	}{
+		"all pages": {nameRegex: "", want: total},
+		"filtered":  {nameRegex: "^Screen 10[0-9]$", want: 4},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			schemaResp := &datasource.SchemaResponse{}
+			d.Schema(ctx, datasource.SchemaRequest{}, schemaResp)
+
+			var nameRegex interface{}
+			if tt.nameRegex != "" {
+				nameRegex = tt.nameRegex
+			}
+			schemaType := schemaResp.Schema.Type().TerraformType(ctx)
+			config := tfsdk.Config{
+				Schema: schemaResp.Schema,
+				Raw: tftypes.NewValue(schemaType, map[string]tftypes.Value{
+					"id":            tftypes.NewValue(tftypes.String, nil),
+					"ids":           tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, nil),
+					"name_regex":    tftypes.NewValue(tftypes.String, nameRegex),
+					"issue_screens": tftypes.NewValue(schemaType.(tftypes.Object).AttributeTypes["issue_screens"], nil),
+					"site":          tftypes.NewValue(tftypes.String, nil),
+				}),
+			}
+			state := tfsdk.State{
+				Schema: schemaResp.Schema,
+				Raw:    tftypes.NewValue(schemaType, nil),
+			}
+
+			resp := &datasource.ReadResponse{State: state}
+			d.Read(ctx, datasource.ReadRequest{Config: config}, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+
+			var got jiraIssueScreensDataSourceModel
+			resp.Diagnostics.Append(resp.State.Get(ctx, &got)...)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+			if len(got.IssueScreens) != tt.want {
+				t.Errorf("expected %d issue screens, got: %d", tt.want, len(got.IssueScreens))
+			}
+		})
+	}
+}
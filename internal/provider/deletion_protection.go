@@ -0,0 +1,111 @@
+package atlassian
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// deletionProtectionAttribute returns the `deletion_protection` attribute of the resources which
+// can be protected from deletion.
+func deletionProtectionAttribute(object string) schema.BoolAttribute {
+	return schema.BoolAttribute{
+		MarkdownDescription: fmt.Sprintf("Whether to prevent Terraform from deleting the %s. Defaults to `false`. "+
+			"To destroy a protected %s, set `deletion_protection` to `false` and apply the configuration first.", object, object),
+		Optional: true,
+	}
+}
+
+// deletionProtectionDiagnostics returns the error of the deletion of a resource whose deletion
+// protection is enabled.
+func deletionProtectionDiagnostics(object, name string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	diags.AddAttributeError(path.Root("deletion_protection"), "Deletion Protection Enabled",
+		fmt.Sprintf("The %s %q cannot be deleted because `deletion_protection` is enabled. "+
+			"Set `deletion_protection` to `false` and apply the configuration before deleting it.", object, name))
+	return diags
+}
+
+// replacePlanDiagnostics checks the plan to replace a resource, which deletes it before creating
+// it again, e.g. when its name changes. It fails when the deletion protection of the resource is
+// enabled in its state, so that the plan fails instead of the apply. Resource plan modifiers run
+// after the attribute plan modifiers, so resp holds the attributes requiring the replacement.
+func replacePlanDiagnostics(ctx context.Context, object string, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() || len(resp.RequiresReplace) == 0 {
+		return diags
+	}
+
+	var protected types.Bool
+	var name types.String
+	diags.Append(req.State.GetAttribute(ctx, path.Root("deletion_protection"), &protected)...)
+	diags.Append(req.State.GetAttribute(ctx, path.Root("name"), &name)...)
+	if diags.HasError() || !protected.ValueBool() {
+		return diags
+	}
+
+	attributes := make([]string, 0, len(resp.RequiresReplace))
+	for _, p := range resp.RequiresReplace {
+		attributes = append(attributes, "`"+p.String()+"`")
+	}
+	diags.AddAttributeError(path.Root("deletion_protection"), "Deletion Protection Enabled",
+		fmt.Sprintf("The %s %q cannot be replaced because `deletion_protection` is enabled, and changing %s forces a new resource. "+
+			"Set `deletion_protection` to `false` and apply the configuration before changing it.", object, name.ValueString(), strings.Join(attributes, ", ")))
+	return diags
+}
+
+// schemeUsageFunc returns a description of the objects using a scheme, e.g. "the projects: TEST1, TEST2",
+// or an empty string when the scheme is unused.
+type schemeUsageFunc func(ctx context.Context) (string, error)
+
+// destroyPlanDiagnostics checks the plan to destroy a resource. It fails when the deletion
+// protection of the resource is enabled, and warns when the scheme is still in use, as the objects
+// using it may only stop using it later in the apply, e.g. when their association resources are
+// destroyed. Resources which cannot be in use, or whose usages are too costly to find on every
+// plan, pass a nil usage function.
+func destroyPlanDiagnostics(ctx context.Context, object, name string, protected, forceDetach bool, usage schemeUsageFunc) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	usedBy := ""
+	if usage != nil {
+		var err error
+		usedBy, err = usage(ctx)
+		if err != nil {
+			diags.AddWarning(fmt.Sprintf("Unable to check usages of %s", object),
+				fmt.Sprintf("The objects using the %s %q could not be read:\n\n%s", object, name, err))
+		}
+		tflog.Debug(ctx, fmt.Sprintf("Checked usages of %s", object), map[string]interface{}{
+			"usedBy": usedBy,
+		})
+	}
+
+	if protected {
+		diags.Append(deletionProtectionDiagnostics(object, name)...)
+		if usedBy != "" {
+			diags.AddAttributeWarning(path.Root("deletion_protection"), fmt.Sprintf("The %s is in use", object),
+				fmt.Sprintf("The %s %q is used by %s.", object, name, usedBy))
+		}
+		return diags
+	}
+
+	switch {
+	case usedBy == "":
+	case forceDetach:
+		diags.AddAttributeWarning(path.Root("force_detach"), fmt.Sprintf("The %s is in use", object),
+			fmt.Sprintf("The %s %q is used by %s, which will be assigned the default %s before it is deleted.",
+				object, name, usedBy, object))
+	default:
+		diags.AddWarning(fmt.Sprintf("The %s is in use", object),
+			fmt.Sprintf("The %s %q is used by %s. Deleting it will fail unless they no longer use it by then, "+
+				"e.g. because their association resources are destroyed first.", object, name, usedBy))
+	}
+
+	return diags
+}
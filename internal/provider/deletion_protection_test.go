@@ -0,0 +1,163 @@
+package atlassian
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// TestResourceDelete_DeletionProtection checks that the resources with deletion protection
+// enabled are not deleted. The provider has no client, so any request would fail.
+func TestResourceDelete_DeletionProtection(t *testing.T) {
+	tests := map[string]struct {
+		resource   func() resource.Resource
+		attributes map[string]string
+	}{
+		"group":                            {resource: NewJiraGroupResource, attributes: map[string]string{"name": "test-group"}},
+		"issue_field_configuration_scheme": {resource: NewJiraIssueFieldConfigurationSchemeResource, attributes: map[string]string{"id": "10000", "name": "test"}},
+		"issue_type_scheme":                {resource: NewJiraIssueTypeSchemeResource, attributes: map[string]string{"id": "10000", "name": "test"}},
+		"issue_type_screen_scheme":         {resource: NewJiraIssueTypeScreenSchemeResource, attributes: map[string]string{"id": "10000", "name": "test"}},
+		"permission_scheme":                {resource: NewJiraPermissionSchemeResource, attributes: map[string]string{"id": "10000", "name": "test"}},
+		"screen_scheme":                    {resource: NewJiraScreenSchemeResource, attributes: map[string]string{"id": "10000", "name": "test"}},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			r := tt.resource()
+
+			state := testResourceState(t, r, tt.attributes)
+			if diags := state.SetAttribute(ctx, path.Root("deletion_protection"), true); diags.HasError() {
+				t.Fatalf("unable to set deletion_protection: %v", diags)
+			}
+
+			resp := &resource.DeleteResponse{State: state}
+			r.Delete(ctx, resource.DeleteRequest{State: state}, resp)
+			if !resp.Diagnostics.HasError() || resp.Diagnostics.Errors()[0].Summary() != "Deletion Protection Enabled" {
+				t.Fatalf("expected deletion protection error, got: %v", resp.Diagnostics)
+			}
+		})
+	}
+}
+
+// TestResourceModifyPlan_ReplaceDeletionProtection checks that the plans to replace the resources
+// with deletion protection enabled fail. The provider has no client, so only the replacement is
+// checked.
+func TestResourceModifyPlan_ReplaceDeletionProtection(t *testing.T) {
+	resources := map[string]func() resource.Resource{
+		"group":                            NewJiraGroupResource,
+		"issue_field_configuration_scheme": NewJiraIssueFieldConfigurationSchemeResource,
+		"issue_type_scheme":                NewJiraIssueTypeSchemeResource,
+		"issue_type_screen_scheme":         NewJiraIssueTypeScreenSchemeResource,
+		"permission_scheme":                NewJiraPermissionSchemeResource,
+		"screen_scheme":                    NewJiraScreenSchemeResource,
+	}
+	tests := map[string]struct {
+		protected       bool
+		requiresReplace path.Paths
+		wantError       bool
+	}{
+		"protected replace":   {protected: true, requiresReplace: path.Paths{path.Root("name")}, wantError: true},
+		"protected update":    {protected: true, requiresReplace: path.Paths{}, wantError: false},
+		"unprotected replace": {protected: false, requiresReplace: path.Paths{path.Root("name")}, wantError: false},
+	}
+	for name, newResource := range resources {
+		for testName, tt := range tests {
+			t.Run(name+"/"+testName, func(t *testing.T) {
+				ctx := context.Background()
+				r := newResource()
+
+				state := testResourceState(t, r, map[string]string{"id": "10000", "name": "test"})
+				if diags := state.SetAttribute(ctx, path.Root("deletion_protection"), tt.protected); diags.HasError() {
+					t.Fatalf("unable to set deletion_protection: %v", diags)
+				}
+				plan := tfsdk.Plan{Schema: state.Schema, Raw: state.Raw.Copy()}
+
+				resp := &resource.ModifyPlanResponse{Plan: plan, RequiresReplace: tt.requiresReplace}
+				r.(resource.ResourceWithModifyPlan).ModifyPlan(ctx, resource.ModifyPlanRequest{State: state, Plan: plan}, resp)
+				if got := resp.Diagnostics.HasError(); got != tt.wantError {
+					t.Fatalf("expected error %t, got: %v", tt.wantError, resp.Diagnostics)
+				}
+				if tt.wantError && !strings.Contains(resp.Diagnostics.Errors()[0].Detail(), "changing `name` forces a new resource") {
+					t.Errorf("expected error naming the replaced attribute, got: %v", resp.Diagnostics)
+				}
+			})
+		}
+	}
+}
+
+// TestJiraPermissionSchemeResource_ModifyPlanDestroy checks that the plans to destroy a permission
+// scheme do not request the projects using it. The provider has no client, so any request would fail.
+func TestJiraPermissionSchemeResource_ModifyPlanDestroy(t *testing.T) {
+	ctx := context.Background()
+
+	tests := map[string]struct {
+		protected bool
+		wantError bool
+	}{
+		"protected":   {protected: true, wantError: true},
+		"unprotected": {protected: false, wantError: false},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			r := NewJiraPermissionSchemeResource()
+			r.(resource.ResourceWithConfigure).Configure(ctx, resource.ConfigureRequest{ProviderData: &atlassianProvider{}}, &resource.ConfigureResponse{})
+
+			state := testResourceState(t, r, map[string]string{"id": "10000", "name": "test"})
+			if diags := state.SetAttribute(ctx, path.Root("deletion_protection"), tt.protected); diags.HasError() {
+				t.Fatalf("unable to set deletion_protection: %v", diags)
+			}
+			plan := tfsdk.Plan{Schema: state.Schema, Raw: tftypes.NewValue(state.Raw.Type(), nil)}
+
+			resp := &resource.ModifyPlanResponse{Plan: plan}
+			r.(resource.ResourceWithModifyPlan).ModifyPlan(ctx, resource.ModifyPlanRequest{State: state, Plan: plan}, resp)
+			if got := resp.Diagnostics.HasError(); got != tt.wantError {
+				t.Errorf("expected error %t, got: %v", tt.wantError, resp.Diagnostics)
+			}
+
+			if warnings := resp.Diagnostics.Warnings(); len(warnings) != 0 {
+				t.Errorf("expected no warnings, got: %v", warnings)
+			}
+		})
+	}
+}
+
+func TestJiraIssueTypeSchemeResource_ModifyPlanDestroy(t *testing.T) {
+	ctx := context.Background()
+	client, _ := newTestSchemeProjectsServer(t)
+
+	tests := map[string]struct {
+		protected bool
+		wantError bool
+	}{
+		"protected":   {protected: true, wantError: true},
+		"unprotected": {protected: false, wantError: false},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			r := NewJiraIssueTypeSchemeResource()
+			r.(resource.ResourceWithConfigure).Configure(ctx, resource.ConfigureRequest{ProviderData: &atlassianProvider{jira: client}}, &resource.ConfigureResponse{})
+
+			state := testResourceState(t, r, map[string]string{"id": "10000", "name": "test"})
+			if diags := state.SetAttribute(ctx, path.Root("deletion_protection"), tt.protected); diags.HasError() {
+				t.Fatalf("unable to set deletion_protection: %v", diags)
+			}
+			plan := tfsdk.Plan{Schema: state.Schema, Raw: tftypes.NewValue(state.Raw.Type(), nil)}
+
+			resp := &resource.ModifyPlanResponse{Plan: plan}
+			r.(resource.ResourceWithModifyPlan).ModifyPlan(ctx, resource.ModifyPlanRequest{State: state, Plan: plan}, resp)
+			if got := resp.Diagnostics.HasError(); got != tt.wantError {
+				t.Errorf("expected error %t, got: %v", tt.wantError, resp.Diagnostics)
+			}
+
+			warnings := resp.Diagnostics.Warnings()
+			if len(warnings) != 1 || !strings.Contains(warnings[0].Detail(), "TEST1") {
+				t.Errorf("expected warning listing project TEST1, got: %v", warnings)
+			}
+		})
+	}
+}
@@ -0,0 +1,166 @@
+package atlassian
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// atlassianAPIError is the error returned by the Atlassian REST APIs, e.g.
+//
+//	{"errorMessages": ["Issue type scheme not found."], "errors": {"name": "The name is too long."}}
+type atlassianAPIError struct {
+	StatusCode    int
+	ErrorMessages []string          `json:"errorMessages"`
+	Errors        map[string]string `json:"errors"`
+	// RetryAfter is the value of the Retry-After header of rate limited responses.
+	RetryAfter string
+	// Body is the raw response body, used when it cannot be parsed.
+	Body string
+	Err  error
+}
+
+func (e *atlassianAPIError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *atlassianAPIError) Unwrap() error {
+	return e.Err
+}
+
+// messages returns the error messages of the response, or its raw body if there are none.
+func (e *atlassianAPIError) messages() string {
+	if len(e.ErrorMessages) == 0 && len(e.Errors) == 0 {
+		return e.Body
+	}
+	messages := append([]string{}, e.ErrorMessages...)
+	for _, field := range e.fields() {
+		messages = append(messages, fmt.Sprintf("%s: %s", field, e.Errors[field]))
+	}
+	return strings.Join(messages, "\n")
+}
+
+func (e *atlassianAPIError) fields() []string {
+	fields := make([]string, 0, len(e.Errors))
+	for field := range e.Errors {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+	return fields
+}
+
+// newAtlassianAPIError parses the error of a request to the Atlassian REST APIs.
+func newAtlassianAPIError(res *models.ResponseScheme, err error) *atlassianAPIError {
+	apiErr := &atlassianAPIError{Err: err}
+	if res == nil {
+		return apiErr
+	}
+
+	apiErr.StatusCode = res.Code
+	apiErr.Body = res.Bytes.String()
+	if res.Response != nil {
+		apiErr.RetryAfter = res.Header.Get("Retry-After")
+	}
+	// Responses which are not JSON, e.g. HTML error pages of proxies, are kept in Body
+	_ = json.Unmarshal(res.Bytes.Bytes(), apiErr)
+
+	return apiErr
+}
+
+// clientErrorDiagnostics returns the diagnostics of a failed request to the Atlassian REST APIs,
+// where action describes the request, e.g. "create issue type". Errors of specific fields are
+// attached to the attribute with the snake case name of the field, e.g. "issueTypeIds" to
+// "issue_type_ids".
+func clientErrorDiagnostics(action string, res *models.ResponseScheme, err error) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	apiErr := newAtlassianAPIError(res, err)
+	detail := fmt.Sprintf("Unable to %s, got error: %s", action, apiErr.Err)
+	if messages := apiErr.messages(); messages != "" {
+		detail += "\n" + messages
+	}
+
+	switch apiErr.StatusCode {
+	case http.StatusUnauthorized:
+		diags.AddError("Authentication Failed",
+			detail+"\n\nCheck that the username and API token of the provider are valid and that the API token has not expired.")
+	case http.StatusForbidden:
+		diags.AddError("Permission Denied",
+			detail+"\n\nCheck that the user of the provider has the global or project permissions required to "+action+".")
+	case http.StatusNotFound:
+		diags.AddError("Resource Not Found",
+			detail+"\n\nThe resource does not exist or the user of the provider does not have permission to view it.")
+	case http.StatusConflict:
+		diags.AddError("Resource Conflict",
+			detail+"\n\nThe resource conflicts with an existing resource or was modified concurrently. Check for duplicate names and retry.")
+	case http.StatusTooManyRequests:
+		retry := "Reduce the parallelism of Terraform, e.g. with -parallelism=2, and retry."
+		if apiErr.RetryAfter != "" {
+			retry = fmt.Sprintf("Retry after %s seconds or reduce the parallelism of Terraform, e.g. with -parallelism=2.", apiErr.RetryAfter)
+		}
+		diags.AddError("Rate Limit Exceeded", detail+"\n\n"+retry)
+	default:
+		if len(apiErr.Errors) == 0 {
+			diags.AddError("Client Error", detail)
+			return diags
+		}
+		for _, message := range apiErr.ErrorMessages {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to %s, got error: %s", action, message))
+		}
+		for _, field := range apiErr.fields() {
+			diags.AddAttributeError(path.Root(snakeCase(field)), "Invalid Attribute Value",
+				fmt.Sprintf("Unable to %s, got error: %s", action, apiErr.Errors[field]))
+		}
+	}
+
+	return diags
+}
+
+// clientErrorAttributeDiagnostics returns the diagnostics of a failed request like
+// clientErrorDiagnostics, attached to the attribute at p whose value the request was made for.
+func clientErrorAttributeDiagnostics(p path.Path, action string, res *models.ResponseScheme, err error) diag.Diagnostics {
+	var diags diag.Diagnostics
+	for _, d := range clientErrorDiagnostics(action, res, err) {
+		diags.Append(diag.WithPath(p, d))
+	}
+	return diags
+}
+
+// isNotFound reports whether a request to the Atlassian REST APIs failed because the
+// requested resource does not exist.
+func isNotFound(res *models.ResponseScheme) bool {
+	return res != nil && res.Code == http.StatusNotFound
+}
+
+// removeNotFoundResource removes a resource which was deleted outside Terraform from the state,
+// so that the next plan recreates it. name describes the resource, e.g. "issue type".
+func removeNotFoundResource(ctx context.Context, resp *resource.ReadResponse, name string) {
+	tflog.Warn(ctx, fmt.Sprintf("Unable to find %s in API state, removing resource from state", name))
+	resp.State.RemoveResource(ctx)
+}
+
+// snakeCase converts a camel case field name of the Atlassian REST APIs to snake case.
+func snakeCase(s string) string {
+	var b strings.Builder
+	runes := []rune(s)
+	for i, r := range runes {
+		if unicode.IsUpper(r) {
+			if i > 0 && (unicode.IsLower(runes[i-1]) || i+1 < len(runes) && unicode.IsLower(runes[i+1])) {
+				b.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
@@ -0,0 +1,134 @@
+package atlassian
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+func newTestResponse(code int, header http.Header, body string) *models.ResponseScheme {
+	return &models.ResponseScheme{
+		Response: &http.Response{StatusCode: code, Header: header},
+		Code:     code,
+		Bytes:    *bytes.NewBufferString(body),
+	}
+}
+
+func TestNewAtlassianAPIError(t *testing.T) {
+	err := errors.New("request failed")
+	res := newTestResponse(http.StatusBadRequest, http.Header{},
+		`{"errorMessages": ["Something went wrong."], "errors": {"name": "The name is too long.", "issueTypeIds": "Invalid issue type."}}`)
+
+	apiErr := newAtlassianAPIError(res, err)
+	if apiErr.StatusCode != http.StatusBadRequest {
+		t.Errorf("expected status code %d, got: %d", http.StatusBadRequest, apiErr.StatusCode)
+	}
+	if !errors.Is(apiErr, err) {
+		t.Errorf("expected error to wrap %q", err)
+	}
+	want := "Something went wrong.\nissueTypeIds: Invalid issue type.\nname: The name is too long."
+	if got := apiErr.messages(); got != want {
+		t.Errorf("expected messages %q, got: %q", want, got)
+	}
+
+	apiErr = newAtlassianAPIError(newTestResponse(http.StatusBadGateway, http.Header{}, "<html>Bad Gateway</html>"), err)
+	if got := apiErr.messages(); got != "<html>Bad Gateway</html>" {
+		t.Errorf("expected raw body, got: %q", got)
+	}
+
+	apiErr = newAtlassianAPIError(nil, err)
+	if apiErr.StatusCode != 0 || apiErr.messages() != "" {
+		t.Errorf("expected empty error without response, got: %+v", apiErr)
+	}
+}
+
+func TestClientErrorDiagnostics(t *testing.T) {
+	err := errors.New("request failed")
+	tests := map[string]struct {
+		res     *models.ResponseScheme
+		summary string
+		detail  string
+	}{
+		"no response":  {res: nil, summary: "Client Error", detail: "Unable to create group, got error: request failed"},
+		"unauthorized": {res: newTestResponse(http.StatusUnauthorized, http.Header{}, ""), summary: "Authentication Failed", detail: "API token"},
+		"forbidden":    {res: newTestResponse(http.StatusForbidden, http.Header{}, ""), summary: "Permission Denied", detail: "permissions required to create group"},
+		"not found":    {res: newTestResponse(http.StatusNotFound, http.Header{}, `{"errorMessages": ["Group not found."]}`), summary: "Resource Not Found", detail: "Group not found."},
+		"conflict":     {res: newTestResponse(http.StatusConflict, http.Header{}, ""), summary: "Resource Conflict", detail: "duplicate names"},
+		"rate limited": {res: newTestResponse(http.StatusTooManyRequests, http.Header{"Retry-After": []string{"30"}}, ""), summary: "Rate Limit Exceeded", detail: "Retry after 30 seconds"},
+		"bad request":  {res: newTestResponse(http.StatusBadRequest, http.Header{}, "invalid"), summary: "Client Error", detail: "invalid"},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			diags := clientErrorDiagnostics("create group", tt.res, err)
+			if len(diags) != 1 {
+				t.Fatalf("expected 1 diagnostic, got: %d", len(diags))
+			}
+			if got := diags[0].Summary(); got != tt.summary {
+				t.Errorf("expected summary %q, got: %q", tt.summary, got)
+			}
+			if got := diags[0].Detail(); !strings.Contains(got, tt.detail) {
+				t.Errorf("expected detail to contain %q, got: %q", tt.detail, got)
+			}
+		})
+	}
+}
+
+func TestClientErrorDiagnostics_FieldErrors(t *testing.T) {
+	res := newTestResponse(http.StatusBadRequest, http.Header{},
+		`{"errorMessages": ["Something went wrong."], "errors": {"issueTypeIds": "Invalid issue type."}}`)
+
+	diags := clientErrorDiagnostics("create issue type scheme", res, errors.New("request failed"))
+	if len(diags) != 2 {
+		t.Fatalf("expected 2 diagnostics, got: %d", len(diags))
+	}
+	if got := diags[0].Summary(); got != "Client Error" {
+		t.Errorf("expected summary %q, got: %q", "Client Error", got)
+	}
+	attrDiag, ok := diags[1].(interface{ Path() path.Path })
+	if !ok {
+		t.Fatalf("expected attribute diagnostic, got: %T", diags[1])
+	}
+	if !attrDiag.Path().Equal(path.Root("issue_type_ids")) {
+		t.Errorf("expected path %q, got: %q", "issue_type_ids", attrDiag.Path())
+	}
+}
+
+func TestClientErrorAttributeDiagnostics(t *testing.T) {
+	res := newTestResponse(http.StatusForbidden, http.Header{}, "")
+
+	diags := clientErrorAttributeDiagnostics(path.Root("item").AtName("id"), "find issue field configuration item", res, errors.New("request failed"))
+	if len(diags) != 1 {
+		t.Fatalf("expected 1 diagnostic, got: %d", len(diags))
+	}
+	if got := diags[0].Summary(); got != "Permission Denied" {
+		t.Errorf("expected summary %q, got: %q", "Permission Denied", got)
+	}
+	attrDiag, ok := diags[0].(interface{ Path() path.Path })
+	if !ok {
+		t.Fatalf("expected attribute diagnostic, got: %T", diags[0])
+	}
+	if !attrDiag.Path().Equal(path.Root("item").AtName("id")) {
+		t.Errorf("expected path %q, got: %q", "item.id", attrDiag.Path())
+	}
+}
+
+func TestSnakeCase(t *testing.T) {
+	tests := map[string]string{
+		"name":                "name",
+		"issueTypeIds":        "issue_type_ids",
+		"defaultIssueTypeId":  "default_issue_type_id",
+		"projectID":           "project_id",
+		"URLPath":             "url_path",
+		"fieldConfigSchemeId": "field_config_scheme_id",
+	}
+	for in, want := range tests {
+		if got := snakeCase(in); got != want {
+			t.Errorf("snakeCase(%q): expected %q, got: %q", in, want, got)
+		}
+	}
+}
@@ -0,0 +1,98 @@
+package atlassian
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// compositeIDSeparator separates the IDs of the objects related by a resource, e.g. a project and
+// a scheme, in the ID of the resource.
+const compositeIDSeparator = ","
+
+// compositeID returns the ID of a resource relating several objects, which is also its import
+// identifier, e.g. 10000,10001 for a project and a scheme.
+func compositeID(ids ...string) string {
+	return strings.Join(ids, compositeIDSeparator)
+}
+
+// importAttribute is an attribute of a resource set from a part of its import identifier.
+type importAttribute struct {
+	path path.Path
+	// numeric is set when the part is the numeric ID of a Jira object, e.g. 10000.
+	numeric bool
+}
+
+// importIDFormat returns the format of the import identifier made of the given attributes, e.g.
+// project_id,permission_scheme_id.
+func importIDFormat(attributes []importAttribute) string {
+	names := make([]string, 0, len(attributes))
+	for _, a := range attributes {
+		names = append(names, a.path.String())
+	}
+	return compositeID(names...)
+}
+
+// parseImportID splits the import identifier into the values of the given attributes. It returns
+// an error describing the part of the identifier which does not match its format. The identifier
+// of a single attribute is not split, e.g. group names may contain commas.
+func parseImportID(id string, attributes []importAttribute) ([]string, error) {
+	parts := []string{id}
+	if len(attributes) > 1 {
+		parts = strings.Split(id, compositeIDSeparator)
+	}
+	if len(parts) != len(attributes) {
+		noun := "parts"
+		if len(parts) == 1 {
+			noun = "part"
+		}
+		return nil, fmt.Errorf("it has %d %s separated by %q instead of %d", len(parts), noun, compositeIDSeparator, len(attributes))
+	}
+	for i, a := range attributes {
+		if parts[i] == "" {
+			return nil, fmt.Errorf("%s is empty", a.path)
+		}
+		if _, err := strconv.Atoi(parts[i]); a.numeric && err != nil {
+			return nil, fmt.Errorf("%s %q is not a number", a.path, parts[i])
+		}
+	}
+	return parts, nil
+}
+
+// importState sets the attributes of a resource from its import identifier, which may be
+// prefixed with the name of a site, see importSite. The identifier joins the values of the
+// attributes like compositeID, and is also the ID of the resources imported by several
+// attributes, so that their state is complete before being read.
+func (p *atlassianProvider) importState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse, attributes ...importAttribute) {
+	id := p.importSite(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	parts, err := parseImportID(id, attributes)
+	if err != nil {
+		detail := fmt.Sprintf("Expected import identifier with format: %s. Got: %q, but %s.", importIDFormat(attributes), id, err)
+		if strings.Contains(id, ":") {
+			detail += "\n\nIf the identifier is prefixed with the name of a site, the site must be configured in the `sites` attribute of the provider."
+		}
+		resp.Diagnostics.AddError("Unexpected Import Identifier", detail)
+		return
+	}
+	tflog.Debug(ctx, "Importing resource", map[string]interface{}{
+		"importIdentifier": id,
+	})
+
+	setsID := false
+	for i, a := range attributes {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, a.path, parts[i])...)
+		setsID = setsID || a.path.Equal(path.Root("id"))
+	}
+	if len(attributes) > 1 && !setsID {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+	}
+}
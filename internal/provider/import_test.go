@@ -0,0 +1,252 @@
+package atlassian
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestCompositeID(t *testing.T) {
+	tests := []struct {
+		TestName string
+		Input    []string
+		Expect   string
+	}{
+		{TestName: "two ids", Input: []string{"10000", "10001"}, Expect: "10000,10001"},
+		{TestName: "three ids", Input: []string{"10000", "10001", "default"}, Expect: "10000,10001,default"},
+		{TestName: "hyphen in id", Input: []string{"jira-administrators", "5b10ac8d82e05b22cc7d4ef5"}, Expect: "jira-administrators,5b10ac8d82e05b22cc7d4ef5"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.TestName, func(t *testing.T) {
+			result := compositeID(tt.Input...)
+			if result != tt.Expect {
+				t.Errorf("got %s, expected %s", result, tt.Expect)
+			}
+		})
+	}
+}
+
+func TestImportIDFormat(t *testing.T) {
+	tests := []struct {
+		TestName string
+		Input    []importAttribute
+		Expect   string
+	}{
+		{TestName: "one attribute", Input: []importAttribute{{path.Root("id"), true}}, Expect: "id"},
+		{TestName: "two attributes", Input: []importAttribute{{path.Root("project_id"), true}, {path.Root("permission_scheme_id"), true}}, Expect: "project_id,permission_scheme_id"},
+		{TestName: "nested attribute", Input: []importAttribute{{path.Root("issue_field_configuration"), true}, {path.Root("item").AtName("id"), false}}, Expect: "issue_field_configuration,item.id"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.TestName, func(t *testing.T) {
+			result := importIDFormat(tt.Input)
+			if result != tt.Expect {
+				t.Errorf("got %s, expected %s", result, tt.Expect)
+			}
+		})
+	}
+}
+
+func TestParseImportID(t *testing.T) {
+	association := []importAttribute{{path.Root("project_id"), true}, {path.Root("permission_scheme_id"), true}}
+	groupUser := []importAttribute{{path.Root("group_name"), false}, {path.Root("account_id"), false}}
+
+	tests := []struct {
+		TestName   string
+		Input      string
+		Attributes []importAttribute
+		Expect     string
+	}{
+		{TestName: "id", Input: "10000", Attributes: []importAttribute{{path.Root("id"), true}}, Expect: "10000"},
+		{TestName: "composite id", Input: "10000,0", Attributes: association, Expect: "10000 0"},
+		{TestName: "comma in single attribute", Input: "admins, jira", Attributes: []importAttribute{{path.Root("name"), false}}, Expect: "admins, jira"},
+		{TestName: "not numeric", Input: "jira-administrators,5b10ac8d82e05b22cc7d4ef5", Attributes: groupUser, Expect: "jira-administrators 5b10ac8d82e05b22cc7d4ef5"},
+		{TestName: "too few parts", Input: "10000", Attributes: association, Expect: `it has 1 part separated by "," instead of 2`},
+		{TestName: "too many parts", Input: "10000,10001,10002", Attributes: association, Expect: `it has 3 parts separated by "," instead of 2`},
+		{TestName: "hyphen separator", Input: "10000-10001", Attributes: association, Expect: `it has 1 part separated by "," instead of 2`},
+		{TestName: "empty part", Input: "10000,", Attributes: association, Expect: "permission_scheme_id is empty"},
+		{TestName: "empty id", Input: "", Attributes: []importAttribute{{path.Root("id"), true}}, Expect: "id is empty"},
+		{TestName: "not a number", Input: "10000,default", Attributes: association, Expect: `permission_scheme_id "default" is not a number`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.TestName, func(t *testing.T) {
+			parts, err := parseImportID(tt.Input, tt.Attributes)
+			result := strings.Join(parts, " ")
+			if err != nil {
+				result = err.Error()
+			}
+			if result != tt.Expect {
+				t.Errorf("got %s, expected %s", result, tt.Expect)
+			}
+		})
+	}
+}
+
+func TestImportState(t *testing.T) {
+	tests := []struct {
+		TestName string
+		TypeName string
+		Input    string
+		Expect   map[string]string
+	}{
+		{
+			TestName: "id",
+			TypeName: "atlassian_jira_issue_type",
+			Input:    "10000",
+			Expect:   map[string]string{"id": "10000"},
+		},
+		{
+			TestName: "name",
+			TypeName: "atlassian_jira_group",
+			Input:    "jira-administrators",
+			Expect:   map[string]string{"name": "jira-administrators"},
+		},
+		{
+			TestName: "association",
+			TypeName: "atlassian_jira_permission_scheme_association",
+			Input:    "10000,0",
+			Expect:   map[string]string{"id": "10000,0", "project_id": "10000", "permission_scheme_id": "0"},
+		},
+		{
+			TestName: "id in composite id",
+			TypeName: "atlassian_jira_permission_grant",
+			Input:    "10100,10000",
+			Expect:   map[string]string{"id": "10100", "permission_scheme_id": "10000"},
+		},
+		{
+			TestName: "mapping",
+			TypeName: "atlassian_jira_issue_field_configuration_scheme_mapping",
+			Input:    "10000,10001,default",
+			Expect:   map[string]string{"id": "10000,10001,default", "field_configuration_scheme_id": "10000", "field_configuration_id": "10001", "issue_type_id": "default"},
+		},
+		{
+			TestName: "group user",
+			TypeName: "atlassian_jira_group_user",
+			Input:    "jira-administrators,5b10ac8d82e05b22cc7d4ef5",
+			Expect:   map[string]string{"id": "jira-administrators,5b10ac8d82e05b22cc7d4ef5", "group_name": "jira-administrators", "account_id": "5b10ac8d82e05b22cc7d4ef5"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.TestName, func(t *testing.T) {
+			state, diags := testImportResourceState(t, tt.TypeName, tt.Input)
+			for _, d := range diags {
+				t.Fatalf("unexpected diagnostic: %s: %s", d.Summary, d.Detail)
+			}
+
+			for name, expect := range tt.Expect {
+				var result string
+				if err := state[name].As(&result); err != nil {
+					t.Fatal(err)
+				}
+				if result != expect {
+					t.Errorf("%s: got %s, expected %s", name, result, expect)
+				}
+			}
+		})
+	}
+}
+
+func TestImportState_PermissionSchemeGrants(t *testing.T) {
+	state, diags := testImportResourceState(t, "atlassian_jira_permission_scheme", "10000")
+	for _, d := range diags {
+		t.Fatalf("unexpected diagnostic: %s: %s", d.Summary, d.Detail)
+	}
+
+	if !state["permissions"].IsKnown() || state["permissions"].IsNull() {
+		t.Errorf("got permissions %s, expected an empty set read with the grants", state["permissions"])
+	}
+}
+
+func TestImportState_Errors(t *testing.T) {
+	tests := []struct {
+		TestName string
+		TypeName string
+		Input    string
+		Expect   string
+	}{
+		{
+			TestName: "hyphen separator",
+			TypeName: "atlassian_jira_workflow_scheme_association",
+			Input:    "10000-10001",
+			Expect:   `Expected import identifier with format: project_id,workflow_scheme_id. Got: "10000-10001", but it has 1 part separated by "," instead of 2.`,
+		},
+		{
+			TestName: "not a number",
+			TypeName: "atlassian_jira_issue_field_configuration_item",
+			Input:    "default,summary",
+			Expect:   `Expected import identifier with format: issue_field_configuration,item.id. Got: "default,summary", but issue_field_configuration "default" is not a number.`,
+		},
+		{
+			TestName: "unknown site",
+			TypeName: "atlassian_jira_status",
+			Input:    "staging:10000",
+			Expect: `Expected import identifier with format: id. Got: "staging:10000", but id "staging:10000" is not a number.` +
+				"\n\nIf the identifier is prefixed with the name of a site, the site must be configured in the `sites` attribute of the provider.",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.TestName, func(t *testing.T) {
+			_, diags := testImportResourceState(t, tt.TypeName, tt.Input)
+			if len(diags) != 1 {
+				t.Fatalf("got %d diagnostics, expected 1", len(diags))
+			}
+			if diags[0].Summary != "Unexpected Import Identifier" {
+				t.Errorf("got summary %s, expected Unexpected Import Identifier", diags[0].Summary)
+			}
+			if diags[0].Detail != tt.Expect {
+				t.Errorf("got %s, expected %s", diags[0].Detail, tt.Expect)
+			}
+		})
+	}
+}
+
+// testImportResourceState imports a resource with the provider server, and returns the attributes
+// of the imported state and the diagnostics.
+func testImportResourceState(t *testing.T, typeName, id string) (map[string]tftypes.Value, []*tfprotov6.Diagnostic) {
+	t.Helper()
+	ctx := context.Background()
+
+	server, err := providerserver.NewProtocol6WithError(New("test")())()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	schemaResp, err := server.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	resourceSchema, ok := schemaResp.ResourceSchemas[typeName]
+	if !ok {
+		t.Fatalf("resource %s not found", typeName)
+	}
+
+	resp, err := server.ImportResourceState(ctx, &tfprotov6.ImportResourceStateRequest{
+		TypeName: typeName,
+		ID:       id,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.ImportedResources) == 0 {
+		return nil, resp.Diagnostics
+	}
+
+	value, err := resp.ImportedResources[0].State.Unmarshal(resourceSchema.ValueType())
+	if err != nil {
+		t.Fatal(err)
+	}
+	var state map[string]tftypes.Value
+	if err := value.As(&state); err != nil {
+		t.Fatal(err)
+	}
+	return state, resp.Diagnostics
+}
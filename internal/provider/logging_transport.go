@@ -0,0 +1,221 @@
+package atlassian
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// httpLogSubsystem is the tflog subsystem of the HTTP requests and responses sent to the Atlassian APIs.
+	// Its level can be set with the TF_LOG_PROVIDER_ATLASSIAN_HTTP environment variable,
+	// and defaults to the level of TF_LOG_PROVIDER.
+	httpLogSubsystem = "http"
+
+	// httpLogMaxBodySize is the maximum number of bytes of a request or response body written to the logs.
+	httpLogMaxBodySize = 64 * 1024
+)
+
+var (
+	// Headers which values are never written to the logs.
+	httpLogRedactedHeaders = []string{"Authorization", "Cookie", "Proxy-Authorization", "Set-Cookie"}
+
+	// Matches JSON properties and query parameters holding secrets, e.g. "apiToken": "foo" or token=foo.
+	httpLogSecretRegexes = []*regexp.Regexp{
+		regexp.MustCompile(`(?i)"[a-z_]*(token|password|secret)"\s*:\s*"[^"]*"`),
+		regexp.MustCompile(`(?i)([?&][a-z_]*(token|password|secret)=)[^&\s"]*`),
+	}
+)
+
+// loggingTransport is an http.RoundTripper that writes the HTTP requests and responses to the
+// provider logs. Log entries include the root fields of the Terraform operation, such as
+// tf_req_id and tf_resource_type, which correlate the requests made by a resource in one operation.
+type loggingTransport struct {
+	transport http.RoundTripper
+	// Secrets, e.g. the API token, masked in every log entry.
+	secrets []string
+}
+
+var _ http.RoundTripper = (*loggingTransport)(nil)
+
+func newLoggingTransport(transport http.RoundTripper, secrets ...string) *loggingTransport {
+	return &loggingTransport{
+		transport: transport,
+		secrets:   secrets,
+	}
+}
+
+func (t *loggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := t.logContext(req.Context())
+
+	reqBody, req, err := httpLogRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+	tflog.SubsystemDebug(ctx, httpLogSubsystem, "Sending HTTP request", map[string]interface{}{
+		"http_method":          req.Method,
+		"http_url":             req.URL.String(),
+		"http_request_headers": httpLogHeaders(req.Header),
+		"http_request_body":    reqBody,
+	})
+
+	start := time.Now()
+	res, err := t.transport.RoundTrip(req)
+	latency := time.Since(start)
+	if err != nil {
+		tflog.SubsystemError(ctx, httpLogSubsystem, "HTTP request failed", map[string]interface{}{
+			"http_method":  req.Method,
+			"http_url":     req.URL.String(),
+			"http_latency": latency.String(),
+			"error":        err.Error(),
+		})
+		return nil, err
+	}
+
+	resBody, body, err := httpLogBody(res.Body, res.ContentLength, res.Header)
+	if err != nil {
+		return nil, err
+	}
+	res.Body = body
+	tflog.SubsystemDebug(ctx, httpLogSubsystem, "Received HTTP response", map[string]interface{}{
+		"http_method":           req.Method,
+		"http_url":              req.URL.String(),
+		"http_status_code":      res.StatusCode,
+		"http_latency":          latency.String(),
+		"http_response_headers": httpLogHeaders(res.Header),
+		"http_response_body":    resBody,
+	})
+
+	return res, nil
+}
+
+// logContext returns ctx with the HTTP subsystem logger, masking secrets and adding a
+// http_request_id field to match each request with its response.
+func (t *loggingTransport) logContext(ctx context.Context) context.Context {
+	ctx = tflog.NewSubsystem(ctx, httpLogSubsystem,
+		tflog.WithRootFields(),
+		tflog.WithLevelFromEnv("TF_LOG_PROVIDER_ATLASSIAN_HTTP"),
+	)
+	ctx = tflog.SubsystemMaskAllFieldValuesRegexes(ctx, httpLogSubsystem, httpLogSecretRegexes...)
+	var secrets []string
+	for _, s := range t.secrets {
+		if s != "" {
+			secrets = append(secrets, s)
+		}
+	}
+	ctx = tflog.SubsystemMaskAllFieldValuesStrings(ctx, httpLogSubsystem, secrets...)
+	ctx = tflog.SubsystemSetField(ctx, httpLogSubsystem, "http_request_id", newHTTPRequestID())
+	return ctx
+}
+
+// httpLogHeaders returns a copy of headers, redacting the values of sensitive headers.
+func httpLogHeaders(headers http.Header) map[string]string {
+	result := make(map[string]string, len(headers))
+	for k, v := range headers {
+		result[k] = strings.Join(v, ", ")
+	}
+	for _, k := range httpLogRedactedHeaders {
+		if _, ok := result[k]; ok {
+			result[k] = "***"
+		}
+	}
+	return result
+}
+
+// httpLogRequestBody returns the body of the request to log, and the request to send. RoundTrip
+// must not modify the request, so the body read to log is either read from a copy returned by
+// GetBody, or replaced on a clone of the request.
+func httpLogRequestBody(req *http.Request) (string, *http.Request, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return "", req, nil
+	}
+
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			req.Body.Close()
+			return "", nil, err
+		}
+		logBody, body, err := httpLogBody(body, req.ContentLength, req.Header)
+		if err != nil {
+			req.Body.Close()
+			return "", nil, err
+		}
+		body.Close()
+		return logBody, req, nil
+	}
+
+	req = req.Clone(req.Context())
+	logBody, body, err := httpLogBody(req.Body, req.ContentLength, req.Header)
+	if err != nil {
+		return "", nil, err
+	}
+	req.Body = body
+	return logBody, req, nil
+}
+
+// httpLogBody returns the body to log, and the body to read instead of body, which replays the
+// bytes read to log. At most httpLogMaxBodySize bytes are read, and bodies that are not text are
+// summarised by their size and content type without being read.
+func httpLogBody(body io.ReadCloser, contentLength int64, headers http.Header) (string, io.ReadCloser, error) {
+	if body == nil || body == http.NoBody {
+		return "", body, nil
+	}
+
+	contentType := headers.Get("Content-Type")
+	if mediaType, _, err := mime.ParseMediaType(contentType); err == nil && !httpLogIsText(mediaType) {
+		if contentLength < 0 {
+			return fmt.Sprintf("<%s>", mediaType), body, nil
+		}
+		return fmt.Sprintf("<%d bytes of %s>", contentLength, mediaType), body, nil
+	}
+
+	b, err := io.ReadAll(io.LimitReader(body, httpLogMaxBodySize+1))
+	if err != nil {
+		body.Close()
+		return "", nil, err
+	}
+	replay := httpLogReplayBody{Reader: io.MultiReader(bytes.NewReader(b), body), Closer: body}
+
+	if len(b) <= httpLogMaxBodySize {
+		return string(b), replay, nil
+	}
+	if contentLength < 0 {
+		return string(b[:httpLogMaxBodySize]) + "... <truncated>", replay, nil
+	}
+	return string(b[:httpLogMaxBodySize]) + fmt.Sprintf("... <truncated %d bytes>", contentLength-httpLogMaxBodySize), replay, nil
+}
+
+// httpLogReplayBody is a body whose first bytes, read to log, are read again before the rest of it.
+type httpLogReplayBody struct {
+	io.Reader
+	io.Closer
+}
+
+func httpLogIsText(mediaType string) bool {
+	if strings.HasPrefix(mediaType, "image/") {
+		return false
+	}
+	return strings.HasPrefix(mediaType, "text/") ||
+		strings.HasSuffix(mediaType, "json") ||
+		strings.HasSuffix(mediaType, "xml") ||
+		mediaType == "application/x-www-form-urlencoded"
+}
+
+func newHTTPRequestID() string {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return "unknown"
+	}
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,161 @@
+package atlassian
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+)
+
+func TestLoggingTransport(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Set-Cookie", "session=secret-cookie")
+		_, _ = w.Write([]byte(`{"id":"10000","apiToken":"secret-response-token"}`))
+	}))
+	defer srv.Close()
+
+	t.Setenv("TF_LOG_PROVIDER_ATLASSIAN_HTTP", "DEBUG")
+
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+
+	client := &http.Client{Transport: newLoggingTransport(http.DefaultTransport, "secret-api-token")}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, srv.URL+"/rest/api/3/foo?token=secret-query-token",
+		strings.NewReader(`{"name":"foo","description":"secret-api-token"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.SetBasicAuth("foo@bar.com", "secret-api-token")
+
+	res, err := client.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, _ := io.ReadAll(res.Body)
+	res.Body.Close()
+	if string(body) != `{"id":"10000","apiToken":"secret-response-token"}` {
+		t.Fatalf("response body was not restored, got: %s", body)
+	}
+
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 2 {
+		t.Fatalf("expected 2 log entries, got %d: %s", len(entries), output.String())
+	}
+
+	request, response := entries[0], entries[1]
+	if request["http_method"] != http.MethodPost || request["@module"] != "provider.http" {
+		t.Errorf("unexpected request log entry: %v", request)
+	}
+	if response["http_status_code"] != float64(http.StatusOK) || response["http_latency"] == nil {
+		t.Errorf("unexpected response log entry: %v", response)
+	}
+	if request["http_request_id"] == nil || request["http_request_id"] != response["http_request_id"] {
+		t.Errorf("expected request and response to share http_request_id, got %v and %v", request["http_request_id"], response["http_request_id"])
+	}
+
+	logs := output.String()
+	for _, secret := range []string{"secret-api-token", "secret-response-token", "secret-query-token", "secret-cookie", "Basic "} {
+		if strings.Contains(logs, secret) {
+			t.Errorf("expected %q to be redacted from logs: %s", secret, logs)
+		}
+	}
+}
+
+func TestHTTPLogBody_Binary(t *testing.T) {
+	reader := &testLogReader{Reader: bytes.NewReader([]byte{0x89, 'P', 'N', 'G'})}
+	got, body, err := httpLogBody(io.NopCloser(reader), 4, http.Header{"Content-Type": []string{"image/png"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != "<4 bytes of image/png>" {
+		t.Errorf("unexpected log body: %s", got)
+	}
+	if reader.read != 0 {
+		t.Errorf("expected binary body not to be read, got %d bytes read", reader.read)
+	}
+	if b, _ := io.ReadAll(body); len(b) != 4 {
+		t.Errorf("body was not restored, got %d bytes", len(b))
+	}
+}
+
+func TestHTTPLogBody_Truncated(t *testing.T) {
+	text := strings.Repeat("a", httpLogMaxBodySize+100)
+	reader := &testLogReader{Reader: strings.NewReader(text)}
+	got, body, err := httpLogBody(io.NopCloser(reader), int64(len(text)), http.Header{"Content-Type": []string{"application/json"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := text[:httpLogMaxBodySize] + "... <truncated 100 bytes>"; got != want {
+		t.Errorf("unexpected log body of %d bytes", len(got))
+	}
+	if reader.read > httpLogMaxBodySize+1 {
+		t.Errorf("expected at most %d bytes to be read to log, got %d", httpLogMaxBodySize+1, reader.read)
+	}
+	if b, _ := io.ReadAll(body); string(b) != text {
+		t.Errorf("body was not restored, got %d bytes", len(b))
+	}
+}
+
+func TestLoggingTransport_RequestNotModified(t *testing.T) {
+	var received []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		received = append(received, string(b))
+	}))
+	defer srv.Close()
+
+	t.Setenv("TF_LOG_PROVIDER_ATLASSIAN_HTTP", "DEBUG")
+	ctx := tflogtest.RootLogger(context.Background(), io.Discard)
+	transport := newLoggingTransport(http.DefaultTransport)
+
+	tests := map[string]io.Reader{
+		// http.NewRequest sets GetBody for strings.Reader bodies
+		"GetBody":    strings.NewReader(`{"name":"foo"}`),
+		"no GetBody": &testLogReader{Reader: strings.NewReader(`{"name":"foo"}`)},
+	}
+	for name, reader := range tests {
+		t.Run(name, func(t *testing.T) {
+			req, err := http.NewRequestWithContext(ctx, http.MethodPost, srv.URL, reader)
+			if err != nil {
+				t.Fatal(err)
+			}
+			req.Header.Set("Content-Type", "application/json")
+			body := req.Body
+
+			res, err := transport.RoundTrip(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			res.Body.Close()
+
+			if req.Body != body {
+				t.Error("expected request body not to be replaced")
+			}
+			if got := received[len(received)-1]; got != `{"name":"foo"}` {
+				t.Errorf("unexpected body sent: %s", got)
+			}
+		})
+	}
+}
+
+// testLogReader counts the bytes read from a reader.
+type testLogReader struct {
+	io.Reader
+	read int
+}
+
+func (r *testLogReader) Read(p []byte) (int, error) {
+	n, err := r.Reader.Read(p)
+	r.read += n
+	return n, err
+}
@@ -0,0 +1,39 @@
+package atlassian
+
+import "strings"
+
+// prefixedName returns the name sent to the Atlassian APIs for the name of a resource, i.e. the
+// name prefixed with the `name_prefix` of the provider.
+func (p *atlassianProvider) prefixedName(name string) string {
+	return p.namePrefix + name
+}
+
+// unprefixedName returns the name of a resource as set in the configuration, i.e. the name returned
+// by the Atlassian APIs without the `name_prefix` of the provider. Names without the prefix, e.g.
+// of imported resources, are returned as is.
+func (p *atlassianProvider) unprefixedName(name string) string {
+	return strings.TrimPrefix(name, p.namePrefix)
+}
+
+// suffixedDescription returns the description sent to the Atlassian APIs for the description of a
+// resource, i.e. the description followed by the `description_suffix` of the provider, separated
+// by a space.
+func (p *atlassianProvider) suffixedDescription(description string) string {
+	if p.descriptionSuffix == "" {
+		return description
+	}
+	if description == "" {
+		return p.descriptionSuffix
+	}
+	return description + " " + p.descriptionSuffix
+}
+
+// unsuffixedDescription returns the description of a resource as set in the configuration, i.e.
+// the description returned by the Atlassian APIs without the `description_suffix` of the provider.
+// Descriptions without the suffix, e.g. of imported resources, are returned as is.
+func (p *atlassianProvider) unsuffixedDescription(description string) string {
+	if p.descriptionSuffix == "" || !strings.HasSuffix(description, p.descriptionSuffix) {
+		return description
+	}
+	return strings.TrimSuffix(strings.TrimSuffix(description, p.descriptionSuffix), " ")
+}
@@ -0,0 +1,58 @@
+package atlassian
+
+import "testing"
+
+func TestAtlassianProviderNaming(t *testing.T) {
+	tests := map[string]struct {
+		provider    atlassianProvider
+		name        string
+		description string
+		wantName    string
+		wantDesc    string
+	}{
+		"no prefix or suffix": {name: "foo", description: "bar", wantName: "foo", wantDesc: "bar"},
+		"prefix and suffix": {
+			provider: atlassianProvider{namePrefix: "tf-", descriptionSuffix: "(Managed by Terraform)"},
+			name:     "foo", description: "bar",
+			wantName: "tf-foo", wantDesc: "bar (Managed by Terraform)",
+		},
+		"empty description": {
+			provider: atlassianProvider{descriptionSuffix: "(Managed by Terraform)"},
+			name:     "foo", description: "",
+			wantName: "foo", wantDesc: "(Managed by Terraform)",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotName := tt.provider.prefixedName(tt.name)
+			if gotName != tt.wantName {
+				t.Errorf("expected name %q, got: %q", tt.wantName, gotName)
+			}
+			gotDesc := tt.provider.suffixedDescription(tt.description)
+			if gotDesc != tt.wantDesc {
+				t.Errorf("expected description %q, got: %q", tt.wantDesc, gotDesc)
+			}
+
+			// Values returned by the API must round-trip to the configured values
+			if got := tt.provider.unprefixedName(gotName); got != tt.name {
+				t.Errorf("expected unprefixed name %q, got: %q", tt.name, got)
+			}
+			if got := tt.provider.unsuffixedDescription(gotDesc); got != tt.description {
+				t.Errorf("expected unsuffixed description %q, got: %q", tt.description, got)
+			}
+		})
+	}
+}
+
+func TestAtlassianProviderNaming_Unmanaged(t *testing.T) {
+	p := atlassianProvider{namePrefix: "tf-", descriptionSuffix: "(Managed by Terraform)"}
+
+	// Values without the prefix or suffix, e.g. of imported resources, are kept, so that the
+	// next apply adds them.
+	if got := p.unprefixedName("foo"); got != "foo" {
+		t.Errorf("expected name %q, got: %q", "foo", got)
+	}
+	if got := p.unsuffixedDescription("bar"); got != "bar" {
+		t.Errorf("expected description %q, got: %q", "bar", got)
+	}
+}
@@ -0,0 +1,41 @@
+package atlassian
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	jira "github.com/ctreminiom/go-atlassian/jira/v3"
+	"github.com/ctreminiom/go-atlassian/pkg/infra/models"
+)
+
+// IDs of the schemes that Jira assigns to company-managed projects by default.
+// Field configuration and workflow schemes have no ID for their default scheme,
+// see assignDefaultProjectScheme.
+const (
+	jiraDefaultPermissionSchemeID      = "0"
+	jiraDefaultIssueTypeSchemeID       = "10000"
+	jiraDefaultIssueTypeScreenSchemeID = "1"
+	jiraDefaultNotificationSchemeID    = "10000"
+)
+
+// assignDefaultProjectScheme assigns the default scheme to a project by sending a null scheme ID
+// to the given endpoint, e.g. "rest/api/3/workflowscheme/project" with schemeIdField "workflowSchemeId".
+func assignDefaultProjectScheme(ctx context.Context, client *jira.Client, endpoint, schemeIdField, projectId string) (*models.ResponseScheme, error) {
+	payload := map[string]interface{}{
+		schemeIdField: nil,
+		"projectId":   projectId,
+	}
+
+	reader, err := client.TransformStructToReader(&payload)
+	if err != nil {
+		return nil, fmt.Errorf("unable to encode payload: %w", err)
+	}
+
+	request, err := client.NewRequest(ctx, http.MethodPut, endpoint, reader)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.Call(request, nil)
+}
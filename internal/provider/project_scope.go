@@ -0,0 +1,34 @@
+package atlassian
+
+import (
+	"context"
+	"fmt"
+
+	jira "github.com/ctreminiom/go-atlassian/jira/v3"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// validateTeamManagedProject checks that the project used in a `PROJECT` scope exists
+// and is a team-managed (next-gen) project.
+func validateTeamManagedProject(ctx context.Context, client *jira.Client, attributePath path.Path, projectId string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	project, res, err := client.Project.Get(ctx, projectId, nil)
+	if err != nil {
+		if isNotFound(res) {
+			diags.AddAttributeError(attributePath, "Project Not Found",
+				fmt.Sprintf("Project with ID %q does not exist.", projectId))
+			return diags
+		}
+		diags.Append(clientErrorAttributeDiagnostics(attributePath, "get project", res, err)...)
+		return diags
+	}
+
+	if project.Style != "next-gen" && !project.Simplified {
+		diags.AddAttributeError(attributePath, "Invalid Project Scope",
+			fmt.Sprintf("Project with ID %q is a company-managed project. \"PROJECT\" scopes can only be used with team-managed projects.", projectId))
+	}
+
+	return diags
+}
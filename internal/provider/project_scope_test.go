@@ -0,0 +1,65 @@
+package atlassian
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	jira "github.com/ctreminiom/go-atlassian/jira/v3"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+func TestValidateTeamManagedProject(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/rest/api/3/project/10000":
+			fmt.Fprint(w, `{"id": "10000", "style": "next-gen", "simplified": true}`)
+		case "/rest/api/3/project/10001":
+			fmt.Fprint(w, `{"id": "10001", "style": "classic", "simplified": false}`)
+		case "/rest/api/3/project/10002":
+			w.WriteHeader(http.StatusForbidden)
+			fmt.Fprint(w, `{"errorMessages": ["You do not have permission to view this project."]}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, testNotFoundBody)
+		}
+	}))
+	defer srv.Close()
+
+	client, err := jira.New(srv.Client(), srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	attributePath := path.Root("scope").AtName("project_id")
+	tests := map[string]struct {
+		projectId string
+		want      string
+	}{
+		"team-managed":     {projectId: "10000"},
+		"company-managed":  {projectId: "10001", want: "Invalid Project Scope"},
+		"not found":        {projectId: "10003", want: "Project Not Found"},
+		"permission error": {projectId: "10002", want: "Permission Denied"},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			diags := validateTeamManagedProject(context.Background(), client, attributePath, tt.projectId)
+			if tt.want == "" {
+				if diags.HasError() {
+					t.Fatalf("unexpected error: %v", diags)
+				}
+				return
+			}
+			if len(diags) != 1 || diags[0].Summary() != tt.want {
+				t.Fatalf("expected error %q, got: %v", tt.want, diags)
+			}
+			if d, ok := diags[0].(diag.DiagnosticWithPath); !ok || !d.Path().Equal(attributePath) {
+				t.Errorf("expected error attached to %s, got: %v", attributePath, diags[0])
+			}
+		})
+	}
+}
@@ -3,9 +3,15 @@ package atlassian
 import (
 	"context"
 	"os"
+	"strconv"
 
+	"github.com/ctreminiom/go-atlassian/confluence"
+	"github.com/ctreminiom/go-atlassian/jira/agile"
+	"github.com/ctreminiom/go-atlassian/jira/sm"
 	jira "github.com/ctreminiom/go-atlassian/jira/v3"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -15,8 +21,22 @@ import (
 )
 
 type (
+	// atlassianProvider is the provider data shared by the resources and data sources.
 	atlassianProvider struct {
-		jira *jira.Client
+		// The clients of the Jira, Jira Agile, Jira Service Management and Confluence REST APIs
+		// share the HTTP client, site and credentials of the provider.
+		jira              *jira.Client
+		agile             *agile.Client
+		serviceManagement *sm.Client
+		confluence        *confluence.Client
+		// sites are the sites which the resources and data sources can select with their `site`
+		// attribute, instead of the site of the provider `url`.
+		sites map[string]*atlassianSite
+
+		// namePrefix and descriptionSuffix are added to the names and descriptions of the
+		// resources managed by the provider.
+		namePrefix        string
+		descriptionSuffix string
 
 		version string
 	}
@@ -25,6 +45,20 @@ type (
 		Url      types.String `tfsdk:"url"`
 		Username types.String `tfsdk:"username"`
 		ApiToken types.String `tfsdk:"apitoken"`
+
+		ProxyUrl           types.String `tfsdk:"proxy_url"`
+		CaCertPem          types.String `tfsdk:"ca_cert_pem"`
+		CaCertFile         types.String `tfsdk:"ca_cert_file"`
+		ClientCertPem      types.String `tfsdk:"client_cert_pem"`
+		ClientKeyPem       types.String `tfsdk:"client_key_pem"`
+		InsecureSkipVerify types.Bool   `tfsdk:"insecure_skip_verify"`
+
+		ReadCache types.Bool `tfsdk:"read_cache"`
+
+		NamePrefix        types.String `tfsdk:"name_prefix"`
+		DescriptionSuffix types.String `tfsdk:"description_suffix"`
+
+		Sites types.Map `tfsdk:"sites"`
 	}
 )
 
@@ -64,6 +98,91 @@ func (*atlassianProvider) Schema(_ context.Context, _ provider.SchemaRequest, re
 				Optional:            true,
 				Sensitive:           true,
 			},
+			"proxy_url": schema.StringAttribute{
+				MarkdownDescription: "URL of the HTTP proxy used to connect to the Atlassian Host. " +
+					"Defaults to the proxy set with the `HTTPS_PROXY`, `HTTP_PROXY` and `NO_PROXY` environment variables.",
+				Optional: true,
+				Validators: []validator.String{
+					validators.UrlWithScheme("http", "https"),
+				},
+			},
+			"ca_cert_pem": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded certificate authority bundle used to verify the certificate of the Atlassian Host, " +
+					"in addition to the system certificate authorities. Conflicts with `ca_cert_file`.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("ca_cert_file")),
+				},
+			},
+			"ca_cert_file": schema.StringAttribute{
+				MarkdownDescription: "Path to a PEM encoded certificate authority bundle used to verify the certificate of the Atlassian Host, " +
+					"in addition to the system certificate authorities. Conflicts with `ca_cert_pem`.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("ca_cert_pem")),
+				},
+			},
+			"client_cert_pem": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded client certificate used for mutual TLS authentication. Requires `client_key_pem`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("client_key_pem")),
+				},
+			},
+			"client_key_pem": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded private key of the client certificate used for mutual TLS authentication. Requires `client_cert_pem`.",
+				Optional:            true,
+				Sensitive:           true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("client_cert_pem")),
+				},
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				MarkdownDescription: "Whether to skip the verification of the certificate of the Atlassian Host. Defaults to `false`. " +
+					"This should only be used for testing.",
+				Optional: true,
+			},
+			"read_cache": schema.BoolAttribute{
+				MarkdownDescription: "Whether to cache the responses of read requests for the duration of a Terraform run. Defaults to `false`. " +
+					"Identical concurrent requests are sent once, and every write request clears the cache. " +
+					"Can also be set with the `ATLASSIAN_READ_CACHE` environment variable.",
+				Optional: true,
+			},
+			"name_prefix": schema.StringAttribute{
+				MarkdownDescription: "Prefix added to the names of the resources managed by the provider, e.g. `tf-`. " +
+					"The prefix is not part of the `name` attributes, so changing it updates every resource in place.",
+				Optional: true,
+			},
+			"description_suffix": schema.StringAttribute{
+				MarkdownDescription: "Suffix added to the descriptions of the resources managed by the provider, separated by a space, " +
+					"e.g. `(Managed by Terraform)`. The suffix is not part of the `description` attributes, so changing it updates every resource in place.",
+				Optional: true,
+			},
+			"sites": schema.MapNestedAttribute{
+				MarkdownDescription: "Additional Atlassian Hosts, by name, which resources and data sources can manage with their `site` attribute " +
+					"instead of the host of `url`. The requests to every site use the transport and cache settings of the provider.",
+				Optional: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"url": schema.StringAttribute{
+							MarkdownDescription: "Atlassian Host URL of the site.",
+							Required:            true,
+							Validators: []validator.String{
+								validators.UrlWithScheme("https"),
+							},
+						},
+						"username": schema.StringAttribute{
+							MarkdownDescription: "Atlassian Username of the site.",
+							Required:            true,
+						},
+						"apitoken": schema.StringAttribute{
+							MarkdownDescription: "Atlassian API Token of the site.",
+							Required:            true,
+							Sensitive:           true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -149,7 +268,85 @@ func (p *atlassianProvider) Configure(ctx context.Context, req provider.Configur
 		return
 	}
 
-	c, err := jira.New(nil, url)
+	if data.ProxyUrl.IsUnknown() || data.CaCertPem.IsUnknown() || data.CaCertFile.IsUnknown() ||
+		data.ClientCertPem.IsUnknown() || data.ClientKeyPem.IsUnknown() || data.InsecureSkipVerify.IsUnknown() ||
+		data.ReadCache.IsUnknown() {
+		// Cannot connect to client with an unknown value
+		resp.Diagnostics.AddError(
+			"Unable to create client.",
+			"Cannot use unknown values in transport or cache settings.",
+		)
+		return
+	}
+
+	if data.NamePrefix.IsUnknown() || data.DescriptionSuffix.IsUnknown() {
+		resp.Diagnostics.AddError(
+			"Unable to configure provider.",
+			"Cannot use unknown values as name prefix or description suffix.",
+		)
+		return
+	}
+
+	if data.Sites.IsUnknown() {
+		resp.Diagnostics.AddError(
+			"Unable to create client.",
+			"Cannot use unknown value as Sites.",
+		)
+		return
+	}
+
+	siteModels := map[string]atlassianSiteModel{}
+	if !data.Sites.IsNull() {
+		resp.Diagnostics.Append(data.Sites.ElementsAs(ctx, &siteModels, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	sites, diags := newAtlassianSites(siteModels)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.InsecureSkipVerify.ValueBool() {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("insecure_skip_verify"),
+			"Insecure TLS Connection",
+			"The certificate of the Atlassian Host will not be verified, making the connection vulnerable to man-in-the-middle attacks. "+
+				"Use `ca_cert_pem` or `ca_cert_file` to trust a private certificate authority instead.",
+		)
+	}
+
+	httpClient, err := newHTTPClient(transportConfig{
+		ProxyURL:           data.ProxyUrl.ValueString(),
+		CACertPEM:          data.CaCertPem.ValueString(),
+		CACertFile:         data.CaCertFile.ValueString(),
+		ClientCertPEM:      data.ClientCertPem.ValueString(),
+		ClientKeyPEM:       data.ClientKeyPem.ValueString(),
+		InsecureSkipVerify: data.InsecureSkipVerify.ValueBool(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to create client",
+			"Unable to create HTTP transport:\n\n"+err.Error(),
+		)
+		return
+	}
+	secrets := []string{apitoken}
+	for _, site := range sites {
+		secrets = append(secrets, site.apitoken)
+	}
+	httpClient.Transport = newLoggingTransport(httpClient.Transport, secrets...)
+
+	readCache := data.ReadCache.ValueBool()
+	if data.ReadCache.IsNull() {
+		readCache, _ = strconv.ParseBool(os.Getenv("ATLASSIAN_READ_CACHE"))
+	}
+	if readCache {
+		httpClient.Transport = newReadCacheTransport(httpClient.Transport)
+	}
+
+	c, err := jira.New(httpClient, url)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to create client",
@@ -159,46 +356,103 @@ func (p *atlassianProvider) Configure(ctx context.Context, req provider.Configur
 	}
 	c.Auth.SetBasicAuth(username, apitoken)
 
+	agileClient, err := agile.New(httpClient, url)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to create client",
+			"Unable to create Jira Agile client:\n\n"+err.Error(),
+		)
+		return
+	}
+	agileClient.Auth.SetBasicAuth(username, apitoken)
+
+	smClient, err := sm.New(httpClient, url)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to create client",
+			"Unable to create Jira Service Management client:\n\n"+err.Error(),
+		)
+		return
+	}
+	smClient.Auth.SetBasicAuth(username, apitoken)
+
+	confluenceClient, err := confluence.New(httpClient, url)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to create client",
+			"Unable to create Confluence client:\n\n"+err.Error(),
+		)
+		return
+	}
+	confluenceClient.Auth.SetBasicAuth(username, apitoken)
+
+	httpClient.Transport = newSiteTransport(httpClient.Transport, c.Site, sites)
+
 	p.jira = c
+	p.agile = agileClient
+	p.serviceManagement = smClient
+	p.confluence = confluenceClient
+	p.sites = sites
+	p.namePrefix = data.NamePrefix.ValueString()
+	p.descriptionSuffix = data.DescriptionSuffix.ValueString()
 
-	resp.DataSourceData = p.jira
-	resp.ResourceData = p.jira
+	resp.DataSourceData = p
+	resp.ResourceData = p
 }
 
 func (*atlassianProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
+		NewJiraAvatarResource,
 		NewJiraGroupResource,
 		NewJiraGroupUserResource,
 		NewJiraIssueFieldConfigurationItemResource,
 		NewJiraIssueFieldConfigurationResource,
+		NewJiraIssueFieldConfigurationSchemeAssociationResource,
 		NewJiraIssueFieldConfigurationSchemeMappingResource,
 		NewJiraIssueFieldConfigurationSchemeResource,
 		NewJiraIssueScreenResource,
 		NewJiraIssueTypeResource,
+		NewJiraIssueTypeSchemeAssociationResource,
 		NewJiraIssueTypeSchemeResource,
+		NewJiraIssueTypeScreenSchemeAssociationResource,
 		NewJiraIssueTypeScreenSchemeResource,
+		NewJiraNotificationSchemeAssociationResource,
 		NewJiraPermissionGrantResource,
+		NewJiraPermissionSchemeAssociationResource,
 		NewJiraPermissionSchemeResource,
 		NewJiraProjectCategoryResource,
 		NewJiraScreenSchemeResource,
 		NewJiraStatusResource,
+		NewJiraWorkflowSchemeAssociationResource,
 	}
 }
 
 func (*atlassianProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		NewJiraGroupDataSource,
+		NewJiraGroupsDataSource,
 		NewJiraIssueFieldConfigurationDataSource,
 		NewJiraIssueFieldConfigurationSchemeDataSource,
+		NewJiraIssueFieldConfigurationSchemesDataSource,
+		NewJiraIssueFieldConfigurationsDataSource,
 		NewJiraIssueScreenDataSource,
+		NewJiraIssueScreensDataSource,
 		NewJiraIssueTypeDataSource,
 		NewJiraIssueTypeSchemeDataSource,
+		NewJiraIssueTypeSchemesDataSource,
 		NewJiraIssueTypeScreenSchemeDataSource,
+		NewJiraIssueTypeScreenSchemesDataSource,
+		NewJiraIssueTypesDataSource,
 		NewJiraMyselfDataSource,
 		NewJiraPermissionGrantDataSource,
 		NewJiraPermissionSchemeDataSource,
+		NewJiraPermissionSchemesDataSource,
+		NewJiraProjectCategoriesDataSource,
 		NewJiraProjectCategoryDataSource,
 		NewJiraScreenSchemeDataSource,
+		NewJiraScreenSchemesDataSource,
 		NewJiraServerInfoDataSource,
+		NewJiraStatusesDataSource,
+		NewJiraSystemAvatarsDataSource,
 	}
 }
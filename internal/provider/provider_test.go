@@ -32,6 +32,17 @@ func testAccPreCheck(t *testing.T) {
 	}
 }
 
+// testAccEnv returns the value of an environment variable holding the ID of an object the provider
+// cannot create for acceptance tests, e.g. a notification scheme, and skips the test when it is
+// not set.
+func testAccEnv(t *testing.T, name string) string {
+	v := os.Getenv(name)
+	if v == "" {
+		t.Skipf("%s must be set to run this acceptance test.", name)
+	}
+	return v
+}
+
 func TestProvider_InvalidUrlAttribute(t *testing.T) {
 	resource.UnitTest(t, resource.TestCase{
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -0,0 +1,170 @@
+package atlassian
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// readCacheTransport is an http.RoundTripper that caches the responses of GET requests to the
+// Atlassian APIs for the lifetime of the provider, i.e. one Terraform run. Responses are keyed by
+// endpoint and query, concurrent identical requests are collapsed into one request, and any other
+// request, e.g. a POST, PUT or DELETE, invalidates the whole cache.
+type readCacheTransport struct {
+	transport http.RoundTripper
+
+	mu sync.Mutex
+	// generation is incremented on every invalidation, so that responses of requests sent before
+	// a write are not cached after it.
+	generation uint64
+	entries    map[string]*readCacheEntry
+	inflight   map[string]*readCacheCall
+}
+
+// readCacheEntry is a response read by the readCacheTransport.
+type readCacheEntry struct {
+	status     string
+	statusCode int
+	proto      string
+	header     http.Header
+	body       []byte
+}
+
+// readCacheCall is a GET request in flight, shared by concurrent identical requests.
+type readCacheCall struct {
+	done chan struct{}
+	// generation is the generation of the cache when the request was sent.
+	generation uint64
+	entry      *readCacheEntry
+	err        error
+}
+
+var _ http.RoundTripper = (*readCacheTransport)(nil)
+
+func newReadCacheTransport(transport http.RoundTripper) *readCacheTransport {
+	return &readCacheTransport{
+		transport: transport,
+		entries:   map[string]*readCacheEntry{},
+		inflight:  map[string]*readCacheCall{},
+	}
+}
+
+func (t *readCacheTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method != http.MethodGet {
+		t.invalidate()
+		res, err := t.transport.RoundTrip(req)
+		// Reads sent while the write was in flight may have returned the previous API state
+		t.invalidate()
+		return res, err
+	}
+
+	key := req.Method + " " + req.URL.String()
+
+	for {
+		t.mu.Lock()
+		if entry, ok := t.entries[key]; ok {
+			t.mu.Unlock()
+			tflog.Debug(req.Context(), "Using cached HTTP response", map[string]interface{}{
+				"http_url": req.URL.String(),
+			})
+			return entry.response(req), nil
+		}
+		// Requests sent before a write may return the previous API state, so they are only
+		// shared by requests sent after the same write
+		if call, ok := t.inflight[key]; ok && call.generation == t.generation {
+			t.mu.Unlock()
+			select {
+			case <-call.done:
+			case <-req.Context().Done():
+				return nil, req.Context().Err()
+			}
+			// The request was cancelled by the context of the request which sent it, so it is
+			// sent again with the context of this request
+			if errors.Is(call.err, context.Canceled) || errors.Is(call.err, context.DeadlineExceeded) {
+				continue
+			}
+			if call.err != nil {
+				return nil, call.err
+			}
+			tflog.Debug(req.Context(), "Using shared HTTP response of concurrent request", map[string]interface{}{
+				"http_url": req.URL.String(),
+			})
+			return call.entry.response(req), nil
+		}
+		call := &readCacheCall{done: make(chan struct{}), generation: t.generation}
+		t.inflight[key] = call
+		t.mu.Unlock()
+
+		return t.send(req, key, call)
+	}
+}
+
+// send sends a GET request shared by concurrent identical requests, and caches its response
+// unless the cache was invalidated while it was in flight.
+func (t *readCacheTransport) send(req *http.Request, key string, call *readCacheCall) (*http.Response, error) {
+	res, err := t.transport.RoundTrip(req)
+	if err == nil {
+		call.entry, err = newReadCacheEntry(res)
+	}
+	call.err = err
+
+	t.mu.Lock()
+	// A request sent after a write may have replaced this one
+	if t.inflight[key] == call {
+		delete(t.inflight, key)
+	}
+	if err == nil && call.entry.statusCode >= 200 && call.entry.statusCode < 300 && call.generation == t.generation {
+		t.entries[key] = call.entry
+	}
+	t.mu.Unlock()
+	close(call.done)
+
+	if err != nil {
+		return nil, err
+	}
+	return call.entry.response(req), nil
+}
+
+// invalidate removes every cached response.
+func (t *readCacheTransport) invalidate() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.generation++
+	t.entries = map[string]*readCacheEntry{}
+}
+
+func newReadCacheEntry(res *http.Response) (*readCacheEntry, error) {
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return &readCacheEntry{
+		status:     res.Status,
+		statusCode: res.StatusCode,
+		proto:      res.Proto,
+		header:     res.Header.Clone(),
+		body:       body,
+	}, nil
+}
+
+// response returns a copy of the cached response for the request.
+func (e *readCacheEntry) response(req *http.Request) *http.Response {
+	return &http.Response{
+		Status:        e.status,
+		StatusCode:    e.statusCode,
+		Proto:         e.proto,
+		Header:        e.header.Clone(),
+		Body:          io.NopCloser(bytes.NewReader(e.body)),
+		ContentLength: int64(len(e.body)),
+		Request:       req,
+	}
+}
@@ -0,0 +1,226 @@
+package atlassian
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func newTestReadCacheServer(t *testing.T, delay time.Duration) (*httptest.Server, *int32) {
+	t.Helper()
+	var requests int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&requests, 1)
+		time.Sleep(delay)
+		if r.URL.Path == "/missing" {
+			w.WriteHeader(http.StatusNotFound)
+		}
+		_, _ = io.WriteString(w, r.Method+" "+r.URL.String()+" "+strconv.Itoa(int(n)))
+	}))
+	t.Cleanup(srv.Close)
+	return srv, &requests
+}
+
+func testReadCacheGet(t *testing.T, client *http.Client, method, url string) string {
+	t.Helper()
+	req, err := http.NewRequest(method, url, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := client.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer res.Body.Close()
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(body)
+}
+
+func TestReadCacheTransport(t *testing.T) {
+	srv, requests := newTestReadCacheServer(t, 0)
+	client := &http.Client{Transport: newReadCacheTransport(http.DefaultTransport)}
+
+	first := testReadCacheGet(t, client, http.MethodGet, srv.URL+"/rest/api/3/issuetype")
+	if got := testReadCacheGet(t, client, http.MethodGet, srv.URL+"/rest/api/3/issuetype"); got != first {
+		t.Errorf("expected cached response %q, got: %q", first, got)
+	}
+	if *requests != 1 {
+		t.Errorf("expected 1 request, got: %d", *requests)
+	}
+
+	// Responses are keyed by endpoint and query
+	testReadCacheGet(t, client, http.MethodGet, srv.URL+"/rest/api/3/issuetype?startAt=50")
+	if *requests != 2 {
+		t.Errorf("expected 2 requests, got: %d", *requests)
+	}
+
+	// Errors are not cached
+	testReadCacheGet(t, client, http.MethodGet, srv.URL+"/missing")
+	testReadCacheGet(t, client, http.MethodGet, srv.URL+"/missing")
+	if *requests != 4 {
+		t.Errorf("expected 4 requests, got: %d", *requests)
+	}
+
+	// Writes invalidate the cache
+	testReadCacheGet(t, client, http.MethodPost, srv.URL+"/rest/api/3/issuetype")
+	if got := testReadCacheGet(t, client, http.MethodGet, srv.URL+"/rest/api/3/issuetype"); got == first {
+		t.Errorf("expected new response after write, got: %q", got)
+	}
+	if *requests != 6 {
+		t.Errorf("expected 6 requests, got: %d", *requests)
+	}
+}
+
+func TestReadCacheTransport_Concurrent(t *testing.T) {
+	srv, requests := newTestReadCacheServer(t, 100*time.Millisecond)
+	client := &http.Client{Transport: newReadCacheTransport(http.DefaultTransport)}
+
+	var wg sync.WaitGroup
+	bodies := make([]string, 10)
+	for i := range bodies {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			bodies[i] = testReadCacheGet(t, client, http.MethodGet, srv.URL+"/rest/api/3/screens")
+		}(i)
+	}
+	wg.Wait()
+
+	if *requests != 1 {
+		t.Errorf("expected concurrent requests to be collapsed into 1 request, got: %d", *requests)
+	}
+	for _, body := range bodies {
+		if !strings.HasPrefix(body, "GET /rest/api/3/screens") || body != bodies[0] {
+			t.Errorf("expected shared response %q, got: %q", bodies[0], body)
+		}
+	}
+}
+
+// newTestBlockingReadCacheServer returns a server whose first request is blocked until release is
+// closed or the request is cancelled, and a channel which is closed when the first request is
+// received.
+func newTestBlockingReadCacheServer(t *testing.T) (srv *httptest.Server, requests *int32, started <-chan struct{}, release chan<- struct{}) {
+	t.Helper()
+	var n int32
+	startedCh := make(chan struct{})
+	releaseCh := make(chan struct{})
+	srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		i := atomic.AddInt32(&n, 1)
+		if r.Method == http.MethodGet && i == 1 {
+			close(startedCh)
+			select {
+			case <-releaseCh:
+			case <-r.Context().Done():
+				return
+			}
+		}
+		_, _ = io.WriteString(w, r.Method+" "+r.URL.String()+" "+strconv.Itoa(int(i)))
+	}))
+	t.Cleanup(srv.Close)
+	t.Cleanup(func() {
+		select {
+		case <-releaseCh:
+		default:
+			close(releaseCh)
+		}
+	})
+	return srv, &n, startedCh, releaseCh
+}
+
+func TestReadCacheTransport_ConcurrentWrite(t *testing.T) {
+	srv, requests, started, release := newTestBlockingReadCacheServer(t)
+	client := &http.Client{Transport: newReadCacheTransport(http.DefaultTransport)}
+
+	first := make(chan string, 1)
+	go func() {
+		first <- testReadCacheGet(t, client, http.MethodGet, srv.URL+"/rest/api/3/screens")
+	}()
+	<-started
+
+	// Reads sent after a write do not share the response of a read sent before it
+	testReadCacheGet(t, client, http.MethodPut, srv.URL+"/rest/api/3/screens/1")
+	secondCh := make(chan string, 1)
+	go func() {
+		secondCh <- testReadCacheGet(t, client, http.MethodGet, srv.URL+"/rest/api/3/screens")
+	}()
+	var second string
+	select {
+	case second = <-secondCh:
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected read after write to be sent, got: read waiting for the read sent before the write")
+	}
+	if second != "GET /rest/api/3/screens 3" {
+		t.Errorf("expected new response after write, got: %q", second)
+	}
+
+	close(release)
+	if got := <-first; got != "GET /rest/api/3/screens 1" {
+		t.Errorf("expected response of first read, got: %q", got)
+	}
+
+	// The response of the read sent before the write is not cached
+	if got := testReadCacheGet(t, client, http.MethodGet, srv.URL+"/rest/api/3/screens"); got != second {
+		t.Errorf("expected cached response %q, got: %q", second, got)
+	}
+	if *requests != 3 {
+		t.Errorf("expected 3 requests, got: %d", *requests)
+	}
+}
+
+func TestReadCacheTransport_CancelledRequest(t *testing.T) {
+	srv, requests, started, _ := newTestBlockingReadCacheServer(t)
+	client := &http.Client{Transport: newReadCacheTransport(http.DefaultTransport)}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, srv.URL+"/rest/api/3/screens", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cancelled := make(chan error)
+	go func() {
+		res, err := client.Do(req)
+		if err == nil {
+			res.Body.Close()
+		}
+		cancelled <- err
+	}()
+	<-started
+
+	shared := make(chan string, 1)
+	go func() {
+		res, err := client.Get(srv.URL + "/rest/api/3/screens")
+		if err != nil {
+			shared <- err.Error()
+			return
+		}
+		defer res.Body.Close()
+		body, _ := io.ReadAll(res.Body)
+		shared <- string(body)
+	}()
+	// Wait for the second read to wait for the response of the first one
+	time.Sleep(100 * time.Millisecond)
+	cancel()
+
+	if err := <-cancelled; !errors.Is(err, context.Canceled) {
+		t.Errorf("expected context canceled error, got: %v", err)
+	}
+	// Reads waiting for a cancelled read send their own request
+	if got := <-shared; got != "GET /rest/api/3/screens 2" {
+		t.Errorf("expected response of second read, got: %q", got)
+	}
+	if *requests != 2 {
+		t.Errorf("expected 2 requests, got: %d", *requests)
+	}
+}
@@ -0,0 +1,330 @@
+package atlassian
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/openscientia/terraform-provider-atlassian/internal/provider/planmodifiers/int64modifiers"
+)
+
+type (
+	jiraAvatarResource struct {
+		p atlassianProvider
+	}
+
+	jiraAvatarResourceModel struct {
+		ID         types.String `tfsdk:"id"`
+		Type       types.String `tfsdk:"type"`
+		OwnerID    types.String `tfsdk:"owner_id"`
+		Source     types.String `tfsdk:"source"`
+		SourceHash types.String `tfsdk:"source_hash"`
+		CropX      types.Int64  `tfsdk:"crop_x"`
+		CropY      types.Int64  `tfsdk:"crop_y"`
+		CropSize   types.Int64  `tfsdk:"crop_size"`
+		FileName   types.String `tfsdk:"file_name"`
+
+		Site types.String `tfsdk:"site"`
+	}
+)
+
+var (
+	_ resource.Resource               = (*jiraAvatarResource)(nil)
+	_ resource.ResourceWithModifyPlan = (*jiraAvatarResource)(nil)
+)
+
+//tfwaff:ignore import-state the source image of an avatar cannot be read from the API
+func NewJiraAvatarResource() resource.Resource {
+	return &jiraAvatarResource{}
+}
+
+func (*jiraAvatarResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_jira_avatar"
+}
+
+func (*jiraAvatarResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version:             1,
+		MarkdownDescription: "Jira Avatar Resource",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the avatar.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "(Forces new resource) The type of the entity that owns the avatar. " +
+					"Valid values: `issuetype`, `project` and `priority`.",
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(jiraAvatarTypes...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"owner_id": schema.StringAttribute{
+				MarkdownDescription: "(Forces new resource) The ID of the entity that owns the avatar, e.g. the ID of an issue type.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"source": schema.StringAttribute{
+				MarkdownDescription: "(Forces new resource) The path to the PNG or SVG image of the avatar.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`(?i)\.(png|svg)$`), "must be the path to a .png or .svg file"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"source_hash": schema.StringAttribute{
+				MarkdownDescription: "The SHA256 hash of the image at `source`. Changes to the image force a new resource.",
+				Computed:            true,
+			},
+			"crop_x": schema.Int64Attribute{
+				MarkdownDescription: "(Forces new resource) The X coordinate of the top-left corner of the crop region. Defaults to `0`.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64modifiers.DefaultValue(0),
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"crop_y": schema.Int64Attribute{
+				MarkdownDescription: "(Forces new resource) The Y coordinate of the top-left corner of the crop region. Defaults to `0`.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64modifiers.DefaultValue(0),
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"crop_size": schema.Int64Attribute{
+				MarkdownDescription: "(Forces new resource) The length of each side of the square crop region. " +
+					"Defaults to `0`, which uses the largest square that fits the image.",
+				Optional: true,
+				Computed: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64modifiers.DefaultValue(0),
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"file_name": schema.StringAttribute{
+				MarkdownDescription: "The file name of the avatar in Jira.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"site": resourceSiteAttribute(),
+		},
+	}
+}
+
+func (r *jiraAvatarResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	p, ok := req.ProviderData.(*atlassianProvider)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *atlassianProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.p = *p
+}
+
+func (*jiraAvatarResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan jiraAvatarResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Source.IsUnknown() {
+		plan.SourceHash = types.StringUnknown()
+		resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+		return
+	}
+
+	hash, err := jiraAvatarSourceHash(plan.Source.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("source"), "Unable to Read Avatar Image",
+			fmt.Sprintf("Unable to read avatar image, got error: %s", err))
+		return
+	}
+	plan.SourceHash = types.StringValue(hash)
+
+	// Force a new resource if the image at source has changed
+	if !req.State.Raw.IsNull() {
+		var state jiraAvatarResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if !state.SourceHash.IsNull() && state.SourceHash.ValueString() != hash {
+			tflog.Debug(ctx, "Avatar image has changed, forcing replacement", map[string]interface{}{
+				"oldHash": state.SourceHash.ValueString(),
+				"newHash": hash,
+			})
+			plan.ID = types.StringUnknown()
+			plan.FileName = types.StringUnknown()
+			resp.RequiresReplace = append(resp.RequiresReplace, path.Root("source_hash"))
+		}
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+func (r *jiraAvatarResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Creating avatar resource")
+
+	var plan jiraAvatarResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx = withSite(ctx, plan.Site)
+	tflog.Debug(ctx, "Loaded avatar plan", map[string]interface{}{
+		"createPlan": fmt.Sprintf("%+v", plan),
+	})
+
+	avatar, res, err := uploadJiraAvatar(ctx, r.p.jira, plan.Type.ValueString(), plan.OwnerID.ValueString(),
+		int(plan.CropX.ValueInt64()), int(plan.CropY.ValueInt64()), int(plan.CropSize.ValueInt64()), plan.Source.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(clientErrorDiagnostics("create avatar", res, err)...)
+		return
+	}
+	tflog.Debug(ctx, "Created avatar")
+
+	plan.ID = types.StringValue(avatar.ID)
+	plan.FileName = types.StringValue(avatar.FileName)
+
+	tflog.Debug(ctx, "Storing avatar into the state", map[string]interface{}{
+		"createNewState": fmt.Sprintf("%+v", plan),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *jiraAvatarResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Reading avatar resource")
+
+	var state jiraAvatarResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx = withSite(ctx, state.Site)
+	tflog.Debug(ctx, "Loaded avatar from state", map[string]interface{}{
+		"readState": fmt.Sprintf("%+v", state),
+	})
+
+	avatars, res, err := getJiraAvatars(ctx, r.p.jira, state.Type.ValueString(), state.OwnerID.ValueString())
+	if err != nil {
+		if isNotFound(res) {
+			removeNotFoundResource(ctx, resp, "avatar")
+			return
+		}
+		resp.Diagnostics.Append(clientErrorDiagnostics("get avatars", res, err)...)
+		return
+	}
+
+	var avatar *jiraAvatar
+	for _, a := range avatars.Custom {
+		if a.ID == state.ID.ValueString() {
+			avatar = a
+			break
+		}
+	}
+	if avatar == nil {
+		removeNotFoundResource(ctx, resp, "avatar")
+		return
+	}
+	tflog.Debug(ctx, "Retrieved avatar from API state")
+
+	state.FileName = types.StringValue(avatar.FileName)
+	if state.CropX.IsNull() {
+		state.CropX = types.Int64Value(0)
+	}
+	if state.CropY.IsNull() {
+		state.CropY = types.Int64Value(0)
+	}
+	if state.CropSize.IsNull() {
+		state.CropSize = types.Int64Value(0)
+	}
+
+	tflog.Debug(ctx, "Storing avatar into the state", map[string]interface{}{
+		"readNewState": fmt.Sprintf("%+v", state),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *jiraAvatarResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// The RequiresReplace plan modifier will trigger Terraform to destroy and recreate the resource
+	// if any of the configurable attributes or the hash of the image changes.
+	tflog.Debug(ctx, "If the value of any configurable attribute changes, Terraform will destroy and recreate the resource")
+
+	var plan jiraAvatarResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx = withSite(ctx, plan.Site)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *jiraAvatarResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Deleting avatar resource")
+
+	var state jiraAvatarResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded avatar from state")
+	ctx = withSite(ctx, state.Site)
+
+	res, err := deleteJiraAvatar(ctx, r.p.jira, state.Type.ValueString(), state.OwnerID.ValueString(), state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(clientErrorDiagnostics("delete avatar", res, err)...)
+		return
+	}
+	tflog.Debug(ctx, "Deleted avatar from API state")
+
+	// If a Resource type Delete method is completed without error, the framework will automatically remove the resource.
+}
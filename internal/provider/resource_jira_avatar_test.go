@@ -0,0 +1,104 @@
+package atlassian
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccJiraAvatar_Basic(t *testing.T) {
+	resourceName := "atlassian_jira_avatar.test"
+	source := testAccJiraAvatarImage(t, color.RGBA{R: 255, A: 255})
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJiraAvatarConfig_basic(resourceName, source),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "source_hash"),
+					resource.TestCheckResourceAttr(resourceName, "type", "issuetype"),
+					resource.TestCheckResourceAttr(resourceName, "crop_x", "0"),
+					resource.TestCheckResourceAttr(resourceName, "crop_y", "0"),
+					resource.TestCheckResourceAttr(resourceName, "crop_size", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccJiraAvatar_SourceHash(t *testing.T) {
+	resourceName := "atlassian_jira_avatar.test"
+	source := testAccJiraAvatarImage(t, color.RGBA{G: 255, A: 255})
+	var id string
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJiraAvatarConfig_basic(resourceName, source),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrWith(resourceName, "id", func(value string) error {
+						id = value
+						return nil
+					}),
+				),
+			},
+			{
+				PreConfig: func() { testAccJiraAvatarWriteImage(t, source, color.RGBA{B: 255, A: 255}) },
+				Config:    testAccJiraAvatarConfig_basic(resourceName, source),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrWith(resourceName, "id", func(value string) error {
+						if value == id {
+							return fmt.Errorf("expected avatar to be replaced, got same id %q", value)
+						}
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccJiraAvatarConfig_basic(resourceName, source string) string {
+	splits := strings.Split(resourceName, ".")
+	return fmt.Sprintf(`
+	resource %[1]q %[2]q {
+		type = "issuetype"
+		owner_id = "10000"
+		source = %[3]q
+	}
+	`, splits[0], splits[1], source)
+}
+
+// testAccJiraAvatarImage writes a single colour PNG image to a temporary directory and returns its path.
+func testAccJiraAvatarImage(t *testing.T, c color.Color) string {
+	source := filepath.Join(t.TempDir(), "avatar.png")
+	testAccJiraAvatarWriteImage(t, source, c)
+	return source
+}
+
+func testAccJiraAvatarWriteImage(t *testing.T, source string, c color.Color) {
+	img := image.NewRGBA(image.Rect(0, 0, 48, 48))
+	for x := 0; x < 48; x++ {
+		for y := 0; y < 48; y++ {
+			img.Set(x, y, c)
+		}
+	}
+	f, err := os.Create(source)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	if err := png.Encode(f, img); err != nil {
+		t.Fatal(err)
+	}
+}
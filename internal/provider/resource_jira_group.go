@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 
-	jira "github.com/ctreminiom/go-atlassian/jira/v3"
 	"github.com/ctreminiom/go-atlassian/pkg/infra/models"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -30,6 +29,10 @@ type (
 		GroupID types.String `tfsdk:"group_id"`
 		Self    types.String `tfsdk:"self"`
 		Users   types.Set    `tfsdk:"users"`
+
+		DeletionProtection types.Bool `tfsdk:"deletion_protection"`
+
+		Site types.String `tfsdk:"site"`
 	}
 
 	jiraGroupUsersModel struct {
@@ -47,6 +50,7 @@ type (
 var (
 	_ resource.Resource                = (*jiraGroupResource)(nil)
 	_ resource.ResourceWithImportState = (*jiraGroupResource)(nil)
+	_ resource.ResourceWithModifyPlan  = (*jiraGroupResource)(nil)
 )
 
 func NewJiraGroupResource() resource.Resource {
@@ -90,6 +94,7 @@ func (*jiraGroupResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"deletion_protection": deletionProtectionAttribute("group"),
 			"users": schema.SetNestedAttribute{
 				MarkdownDescription: "The list of users in the group.",
 				Computed:            true,
@@ -154,6 +159,7 @@ func (*jiraGroupResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 					setplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"site": resourceSiteAttribute(),
 		},
 	}
 }
@@ -164,20 +170,20 @@ func (r *jiraGroupResource) Configure(ctx context.Context, req resource.Configur
 		return
 	}
 
-	client, ok := req.ProviderData.(*jira.Client)
+	p, ok := req.ProviderData.(*atlassianProvider)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *jira.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *atlassianProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	r.p.jira = client
+	r.p = *p
 }
 
-func (*jiraGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+func (r *jiraGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	r.p.importState(ctx, req, resp, importAttribute{path.Root("name"), false})
 }
 
 func (r *jiraGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -188,17 +194,14 @@ func (r *jiraGroupResource) Create(ctx context.Context, req resource.CreateReque
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	ctx = withSite(ctx, plan.Site)
 	tflog.Debug(ctx, "Loaded group plan", map[string]interface{}{
 		"createPlan": fmt.Sprintf("%+v", plan),
 	})
 
 	group, res, err := r.p.jira.Group.Create(ctx, plan.Name.ValueString())
 	if err != nil {
-		var resBody string
-		if res != nil {
-			resBody = res.Bytes.String()
-		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create group, got error: %s\n%s", err, resBody))
+		resp.Diagnostics.Append(clientErrorDiagnostics("create group", res, err)...)
 		return
 	}
 	tflog.Debug(ctx, "Created group")
@@ -208,11 +211,7 @@ func (r *jiraGroupResource) Create(ctx context.Context, req resource.CreateReque
 	}
 	groupDetails, res, err := r.p.jira.Group.Bulk(ctx, bulkOptions, 0, 1)
 	if err != nil {
-		var resBody string
-		if res != nil {
-			resBody = res.Bytes.String()
-		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to retrieve group details, got error: %s\n%s", err, resBody))
+		resp.Diagnostics.Append(clientErrorDiagnostics("retrieve group details", res, err)...)
 		return
 	}
 
@@ -235,6 +234,7 @@ func (r *jiraGroupResource) Read(ctx context.Context, req resource.ReadRequest,
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	ctx = withSite(ctx, state.Site)
 	tflog.Debug(ctx, "Loaded group from state", map[string]interface{}{
 		"readState": fmt.Sprintf("%+v", state),
 	})
@@ -244,11 +244,15 @@ func (r *jiraGroupResource) Read(ctx context.Context, req resource.ReadRequest,
 	}
 	group, res, err := r.p.jira.Group.Bulk(ctx, bulkOptions, 0, 1)
 	if err != nil {
-		var resBody string
-		if res != nil {
-			resBody = res.Bytes.String()
+		if isNotFound(res) {
+			removeNotFoundResource(ctx, resp, "group")
+			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get group, got error: %s\n%s", err, resBody))
+		resp.Diagnostics.Append(clientErrorDiagnostics("get group", res, err)...)
+		return
+	}
+	if len(group.Values) == 0 {
+		removeNotFoundResource(ctx, resp, "group")
 		return
 	}
 
@@ -259,11 +263,11 @@ func (r *jiraGroupResource) Read(ctx context.Context, req resource.ReadRequest,
 	for !isLast {
 		groupMembers, res, err := r.p.jira.Group.Members(ctx, state.Name.ValueString(), true, startAt, maxResults)
 		if err != nil {
-			var resBody string
-			if res != nil {
-				resBody = res.Bytes.String()
+			if isNotFound(res) {
+				removeNotFoundResource(ctx, resp, "group")
+				return
 			}
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get group members, got error: %s\n%s", err, resBody))
+			resp.Diagnostics.Append(clientErrorDiagnostics("get group members", res, err)...)
 			return
 		}
 		startAt += maxResults
@@ -277,7 +281,7 @@ func (r *jiraGroupResource) Read(ctx context.Context, req resource.ReadRequest,
 
 	state.ID = types.StringValue(group.Values[0].GroupID)
 	state.GroupID = types.StringValue(group.Values[0].GroupID)
-	state.Self = types.StringValue(fmt.Sprintf("https://%s/rest/api/3/group?groupId=%s", r.p.jira.Site.Host, group.Values[0].GroupID))
+	state.Self = types.StringValue(fmt.Sprintf("https://%s/rest/api/3/group?groupId=%s", r.p.siteHost(ctx), group.Values[0].GroupID))
 
 	var users []jiraGroupUsersModel
 	for _, u := range members {
@@ -310,6 +314,37 @@ func (r *jiraGroupResource) Update(ctx context.Context, req resource.UpdateReque
 	// The RequiresReplace plan modifier will trigger Terraform to destroy and recreate the resource
 	// if any of the required attributes changes, i.e. name.
 	tflog.Debug(ctx, "If the value of any required attribute changes, Terraform will destroy and recreate the resource")
+
+	// Only deletion_protection can be updated in place, which is not stored in the API
+	var plan, state jiraGroupResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx = withSite(ctx, state.Site)
+	plan.Users = state.Users
+
+	tflog.Debug(ctx, "Storing group into the state")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *jiraGroupResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Replacing the group deletes it first
+	resp.Diagnostics.Append(replacePlanDiagnostics(ctx, "group", req, resp)...)
+
+	// Otherwise, only the plans to destroy the group are checked
+	if !req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var state jiraGroupResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(destroyPlanDiagnostics(ctx, "group", state.Name.ValueString(), state.DeletionProtection.ValueBool(), false, nil)...)
 }
 
 func (r *jiraGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -320,14 +355,16 @@ func (r *jiraGroupResource) Delete(ctx context.Context, req resource.DeleteReque
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	ctx = withSite(ctx, state.Site)
+
+	if state.DeletionProtection.ValueBool() {
+		resp.Diagnostics.Append(deletionProtectionDiagnostics("group", state.Name.ValueString())...)
+		return
+	}
 
 	res, err := r.p.jira.Group.Delete(ctx, state.Name.ValueString())
 	if err != nil {
-		var resBody string
-		if res != nil {
-			resBody = res.Bytes.String()
-		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete group, got error: %s\n%s", err, resBody))
+		resp.Diagnostics.Append(clientErrorDiagnostics("delete group", res, err)...)
 		return
 	}
 	tflog.Debug(ctx, "Deleted group from API state")
@@ -3,9 +3,7 @@ package atlassian
 import (
 	"context"
 	"fmt"
-	"strings"
 
-	jira "github.com/ctreminiom/go-atlassian/jira/v3"
 	"github.com/ctreminiom/go-atlassian/pkg/infra/models"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -34,12 +32,29 @@ type (
 		Active       types.Bool              `tfsdk:"active"`
 		TimeZone     types.String            `tfsdk:"timezone"`
 		AccountType  types.String            `tfsdk:"account_type"`
+
+		Site types.String `tfsdk:"site"`
+	}
+
+	// jiraGroupUserResourceModelV1 is the model of the schema version 1 of the resource.
+	jiraGroupUserResourceModelV1 struct {
+		ID           types.String            `tfsdk:"id"`
+		GroupName    types.String            `tfsdk:"group_name"`
+		AccountID    types.String            `tfsdk:"account_id"`
+		Self         types.String            `tfsdk:"self"`
+		EmailAddress types.String            `tfsdk:"email_address"`
+		AvatarUrls   *common.AvatarUrlsModel `tfsdk:"avatar_urls"`
+		DisplayName  types.String            `tfsdk:"display_name"`
+		Active       types.Bool              `tfsdk:"active"`
+		TimeZone     types.String            `tfsdk:"timezone"`
+		AccountType  types.String            `tfsdk:"account_type"`
 	}
 )
 
 var (
-	_ resource.Resource                = (*jiraGroupUserResource)(nil)
-	_ resource.ResourceWithImportState = (*jiraGroupUserResource)(nil)
+	_ resource.Resource                 = (*jiraGroupUserResource)(nil)
+	_ resource.ResourceWithImportState  = (*jiraGroupUserResource)(nil)
+	_ resource.ResourceWithUpgradeState = (*jiraGroupUserResource)(nil)
 )
 
 func NewJiraGroupUserResource() resource.Resource {
@@ -52,11 +67,11 @@ func (*jiraGroupUserResource) Metadata(ctx context.Context, req resource.Metadat
 
 func (*jiraGroupUserResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Version:             1,
+		Version:             2,
 		MarkdownDescription: "Jira Group User Resource",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
-				MarkdownDescription: "The ID of the group user. It is computed using `group_name` and `account_id` separated by a hyphen (`-`).",
+				MarkdownDescription: "The ID of the group user. It is computed using `group_name` and `account_id` separated by a comma (`,`).",
 				Computed:            true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
@@ -152,6 +167,7 @@ func (*jiraGroupUserResource) Schema(_ context.Context, _ resource.SchemaRequest
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"site": resourceSiteAttribute(),
 		},
 	}
 }
@@ -162,27 +178,79 @@ func (r *jiraGroupUserResource) Configure(ctx context.Context, req resource.Conf
 		return
 	}
 
-	client, ok := req.ProviderData.(*jira.Client)
+	p, ok := req.ProviderData.(*atlassianProvider)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *jira.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *atlassianProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	r.p.jira = client
+	r.p = *p
 }
 
-func (*jiraGroupUserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, ",")
-	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-		resp.Diagnostics.AddError("Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: group_name, account_id. Got: %q", req.ID))
-		return
+func (r *jiraGroupUserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	r.p.importState(ctx, req, resp,
+		importAttribute{path.Root("group_name"), false},
+		importAttribute{path.Root("account_id"), false},
+	)
+}
+
+func (r *jiraGroupUserResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		// Version 1 separated the IDs in the ID by a hyphen
+		1: {
+			PriorSchema: &schema.Schema{
+				Version: 1,
+				Attributes: map[string]schema.Attribute{
+					"id":            schema.StringAttribute{Computed: true},
+					"group_name":    schema.StringAttribute{Required: true},
+					"account_id":    schema.StringAttribute{Required: true},
+					"self":          schema.StringAttribute{Computed: true},
+					"email_address": schema.StringAttribute{Computed: true},
+					"avatar_urls": schema.SingleNestedAttribute{
+						Computed: true,
+						Attributes: map[string]schema.Attribute{
+							"p16x16": schema.StringAttribute{Computed: true},
+							"p24x24": schema.StringAttribute{Computed: true},
+							"p32x32": schema.StringAttribute{Computed: true},
+							"p48x48": schema.StringAttribute{Computed: true},
+						},
+					},
+					"display_name": schema.StringAttribute{Computed: true},
+					"active":       schema.BoolAttribute{Computed: true},
+					"timezone":     schema.StringAttribute{Computed: true},
+					"account_type": schema.StringAttribute{Computed: true},
+				},
+			},
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				var priorState jiraGroupUserResourceModelV1
+				resp.Diagnostics.Append(req.State.Get(ctx, &priorState)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				upgradedState := jiraGroupUserResourceModel{
+					ID:           types.StringValue(compositeID(priorState.GroupName.ValueString(), priorState.AccountID.ValueString())),
+					GroupName:    priorState.GroupName,
+					AccountID:    priorState.AccountID,
+					Self:         priorState.Self,
+					EmailAddress: priorState.EmailAddress,
+					AvatarUrls:   priorState.AvatarUrls,
+					DisplayName:  priorState.DisplayName,
+					Active:       priorState.Active,
+					TimeZone:     priorState.TimeZone,
+					AccountType:  priorState.AccountType,
+					Site:         types.StringNull(),
+				}
+				tflog.Debug(ctx, "Upgraded group user state from schema version 1", map[string]interface{}{
+					"id": upgradedState.ID.ValueString(),
+				})
+				resp.Diagnostics.Append(resp.State.Set(ctx, upgradedState)...)
+			},
+		},
 	}
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("group_name"), idParts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("account_id"), idParts[1])...)
 }
 
 func (r *jiraGroupUserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -193,17 +261,14 @@ func (r *jiraGroupUserResource) Create(ctx context.Context, req resource.CreateR
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	ctx = withSite(ctx, plan.Site)
 	tflog.Debug(ctx, "Loaded group user plan", map[string]interface{}{
 		"createPlan": fmt.Sprintf("%+v", plan),
 	})
 
 	_, res, err := r.p.jira.Group.Add(ctx, plan.GroupName.ValueString(), plan.AccountID.ValueString())
 	if err != nil {
-		var resBody string
-		if res != nil {
-			resBody = res.Bytes.String()
-		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create group user, got error: %s\n%s", err, resBody))
+		resp.Diagnostics.Append(clientErrorDiagnostics("create group user", res, err)...)
 		return
 	}
 	tflog.Debug(ctx, "Created group user")
@@ -215,11 +280,7 @@ func (r *jiraGroupUserResource) Create(ctx context.Context, req resource.CreateR
 	for !isLast {
 		groupUsers, res, err := r.p.jira.Group.Members(ctx, plan.GroupName.ValueString(), true, startAt, maxResults)
 		if err != nil {
-			var resBody string
-			if res != nil {
-				resBody = res.Bytes.String()
-			}
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get group users, got error: %s\n%s", err, resBody))
+			resp.Diagnostics.Append(clientErrorDiagnostics("get group users", res, err)...)
 			return
 		}
 		startAt += maxResults
@@ -245,7 +306,7 @@ func (r *jiraGroupUserResource) Create(ctx context.Context, req resource.CreateR
 			continue
 		}
 	}
-	plan.ID = types.StringValue(fmt.Sprintf("%s-%s", plan.GroupName.ValueString(), plan.AccountID.ValueString()))
+	plan.ID = types.StringValue(compositeID(plan.GroupName.ValueString(), plan.AccountID.ValueString()))
 
 	tflog.Debug(ctx, "Storing group user into the state", map[string]interface{}{
 		"createNewState": fmt.Sprintf("%+v", plan),
@@ -261,6 +322,7 @@ func (r *jiraGroupUserResource) Read(ctx context.Context, req resource.ReadReque
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	ctx = withSite(ctx, state.Site)
 	tflog.Debug(ctx, "Loaded group user from state", map[string]interface{}{
 		"readState": fmt.Sprintf("%+v", state),
 	})
@@ -272,11 +334,11 @@ func (r *jiraGroupUserResource) Read(ctx context.Context, req resource.ReadReque
 	for !isLast {
 		groupUsers, res, err := r.p.jira.Group.Members(ctx, state.GroupName.ValueString(), true, startAt, maxResults)
 		if err != nil {
-			var resBody string
-			if res != nil {
-				resBody = res.Bytes.String()
+			if isNotFound(res) {
+				removeNotFoundResource(ctx, resp, "group user")
+				return
 			}
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get group users, got error: %s\n%s", err, resBody))
+			resp.Diagnostics.Append(clientErrorDiagnostics("get group users", res, err)...)
 			return
 		}
 		startAt += maxResults
@@ -285,8 +347,10 @@ func (r *jiraGroupUserResource) Read(ctx context.Context, req resource.ReadReque
 	}
 	tflog.Debug(ctx, "Retrieved group users from API state")
 
+	found := false
 	for _, u := range users {
 		if u.AccountID == state.AccountID.ValueString() {
+			found = true
 			state.Self = types.StringValue(u.Self)
 			state.EmailAddress = types.StringValue(u.EmailAddress)
 			state.AvatarUrls = &common.AvatarUrlsModel{
@@ -302,7 +366,11 @@ func (r *jiraGroupUserResource) Read(ctx context.Context, req resource.ReadReque
 			continue
 		}
 	}
-	state.ID = types.StringValue(fmt.Sprintf("%s-%s", state.GroupName.ValueString(), state.AccountID.ValueString()))
+	if !found {
+		removeNotFoundResource(ctx, resp, "group user")
+		return
+	}
+	state.ID = types.StringValue(compositeID(state.GroupName.ValueString(), state.AccountID.ValueString()))
 
 	tflog.Debug(ctx, "Storing group user into the state", map[string]interface{}{
 		"readNewState": fmt.Sprintf("%+v", state),
@@ -324,14 +392,11 @@ func (r *jiraGroupUserResource) Delete(ctx context.Context, req resource.DeleteR
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	ctx = withSite(ctx, state.Site)
 
 	res, err := r.p.jira.Group.Remove(ctx, state.GroupName.ValueString(), state.AccountID.ValueString())
 	if err != nil {
-		var resBody string
-		if res != nil {
-			resBody = res.Bytes.String()
-		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete group user, got error: %s\n%s", err, resBody))
+		resp.Diagnostics.Append(clientErrorDiagnostics("delete group user", res, err)...)
 		return
 	}
 	tflog.Debug(ctx, "Deleted group user from API state")
@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"strconv"
 
-	jira "github.com/ctreminiom/go-atlassian/jira/v3"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -26,6 +25,8 @@ type (
 		ID          types.String `tfsdk:"id"`
 		Name        types.String `tfsdk:"name"`
 		Description types.String `tfsdk:"description"`
+
+		Site types.String `tfsdk:"site"`
 	}
 )
 
@@ -72,6 +73,7 @@ func (*jiraIssueFieldConfigurationResource) Schema(_ context.Context, _ resource
 					stringmodifiers.DefaultValue(""),
 				},
 			},
+			"site": resourceSiteAttribute(),
 		},
 	}
 }
@@ -82,20 +84,20 @@ func (r *jiraIssueFieldConfigurationResource) Configure(ctx context.Context, req
 		return
 	}
 
-	client, ok := req.ProviderData.(*jira.Client)
+	p, ok := req.ProviderData.(*atlassianProvider)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *jira.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *atlassianProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	r.p.jira = client
+	r.p = *p
 }
 
-func (*jiraIssueFieldConfigurationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+func (r *jiraIssueFieldConfigurationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	r.p.importState(ctx, req, resp, importAttribute{path.Root("id"), true})
 }
 
 func (r *jiraIssueFieldConfigurationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -106,17 +108,14 @@ func (r *jiraIssueFieldConfigurationResource) Create(ctx context.Context, req re
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	ctx = withSite(ctx, plan.Site)
 	tflog.Debug(ctx, "Loaded issue field configuration plan", map[string]interface{}{
 		"createPlan": fmt.Sprintf("%+v", plan),
 	})
 
-	issueFieldConfiguration, res, err := r.p.jira.Issue.Field.Configuration.Create(ctx, plan.Name.ValueString(), plan.Description.ValueString())
+	issueFieldConfiguration, res, err := r.p.jira.Issue.Field.Configuration.Create(ctx, r.p.prefixedName(plan.Name.ValueString()), r.p.suffixedDescription(plan.Description.ValueString()))
 	if err != nil {
-		var resBody string
-		if res != nil {
-			resBody = res.Bytes.String()
-		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create issue field configuration, got error: %s\n%s", err, resBody))
+		resp.Diagnostics.Append(clientErrorDiagnostics("create issue field configuration", res, err)...)
 		return
 	}
 	tflog.Debug(ctx, "Created issue field configuration")
@@ -137,6 +136,7 @@ func (r *jiraIssueFieldConfigurationResource) Read(ctx context.Context, req reso
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	ctx = withSite(ctx, state.Site)
 	tflog.Debug(ctx, "Loaded issue field configuration from state", map[string]interface{}{
 		"readState": fmt.Sprintf("%+v", state),
 	})
@@ -144,17 +144,21 @@ func (r *jiraIssueFieldConfigurationResource) Read(ctx context.Context, req reso
 	issueFieldConfigurationId, _ := strconv.Atoi(state.ID.ValueString())
 	issueFieldConfiguration, res, err := r.p.jira.Issue.Field.Configuration.Gets(ctx, []int{issueFieldConfigurationId}, false, 0, 50)
 	if err != nil {
-		var resBody string
-		if res != nil {
-			resBody = res.Bytes.String()
+		if isNotFound(res) {
+			removeNotFoundResource(ctx, resp, "issue field configuration")
+			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get issue field configuration, got error: %s\n%s", err, resBody))
+		resp.Diagnostics.Append(clientErrorDiagnostics("get issue field configuration", res, err)...)
+		return
+	}
+	if len(issueFieldConfiguration.Values) == 0 {
+		removeNotFoundResource(ctx, resp, "issue field configuration")
 		return
 	}
 	tflog.Debug(ctx, "Retrieved issue field configuration from API state")
 
-	state.Name = types.StringValue(issueFieldConfiguration.Values[0].Name)
-	state.Description = types.StringValue(issueFieldConfiguration.Values[0].Description)
+	state.Name = types.StringValue(r.p.unprefixedName(issueFieldConfiguration.Values[0].Name))
+	state.Description = types.StringValue(r.p.unsuffixedDescription(issueFieldConfiguration.Values[0].Description))
 
 	tflog.Debug(ctx, "Storing issue field configuration into the state", map[string]interface{}{
 		"readNewState": fmt.Sprintf("%+v", state),
@@ -170,6 +174,7 @@ func (r *jiraIssueFieldConfigurationResource) Update(ctx context.Context, req re
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	ctx = withSite(ctx, plan.Site)
 	tflog.Debug(ctx, "Loaded issue field configuration plan", map[string]interface{}{
 		"updatePlan": fmt.Sprintf("%+v", plan),
 	})
@@ -184,13 +189,9 @@ func (r *jiraIssueFieldConfigurationResource) Update(ctx context.Context, req re
 	})
 
 	issueFieldConfigurationId, _ := strconv.Atoi(state.ID.ValueString())
-	res, err := r.p.jira.Issue.Field.Configuration.Update(ctx, issueFieldConfigurationId, plan.Name.ValueString(), plan.Description.ValueString())
+	res, err := r.p.jira.Issue.Field.Configuration.Update(ctx, issueFieldConfigurationId, r.p.prefixedName(plan.Name.ValueString()), r.p.suffixedDescription(plan.Description.ValueString()))
 	if err != nil {
-		var resBody string
-		if res != nil {
-			resBody = res.Bytes.String()
-		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update issue field configuration, got error: %s\n%s", err, resBody))
+		resp.Diagnostics.Append(clientErrorDiagnostics("update issue field configuration", res, err)...)
 		return
 	}
 	tflog.Debug(ctx, "Updated issue field configuration in API state")
@@ -210,15 +211,12 @@ func (r *jiraIssueFieldConfigurationResource) Delete(ctx context.Context, req re
 		return
 	}
 	tflog.Debug(ctx, "Loaded issue field configuration from state")
+	ctx = withSite(ctx, state.Site)
 
 	issueFieldConfigurationID, _ := strconv.Atoi(state.ID.ValueString())
 	res, err := r.p.jira.Issue.Field.Configuration.Delete(ctx, issueFieldConfigurationID)
 	if err != nil {
-		var resBody string
-		if res != nil {
-			resBody = res.Bytes.String()
-		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete issue field configuration, got error: %s\n%s", err, resBody))
+		resp.Diagnostics.Append(clientErrorDiagnostics("delete issue field configuration", res, err)...)
 		return
 	}
 	tflog.Debug(ctx, "Removed issue field configuration from API state")
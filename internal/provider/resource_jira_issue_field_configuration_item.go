@@ -7,7 +7,6 @@ import (
 	"strconv"
 	"strings"
 
-	jira "github.com/ctreminiom/go-atlassian/jira/v3"
 	"github.com/ctreminiom/go-atlassian/pkg/infra/models"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -30,6 +29,8 @@ type (
 		ID                      types.String                     `tfsdk:"id"`
 		IssueFieldConfiguration types.String                     `tfsdk:"issue_field_configuration"`
 		Item                    *jiraIssueFieldConfigurationItem `tfsdk:"item"`
+
+		Site types.String `tfsdk:"site"`
 	}
 
 	jiraIssueFieldConfigurationItem struct {
@@ -39,12 +40,29 @@ type (
 		IsRequired  types.Bool   `tfsdk:"is_required"`
 		Renderer    types.String `tfsdk:"renderer"`
 	}
+
+	// jiraIssueFieldConfigurationItemResourceModelV1 is the model of the schema version 1 of the
+	// resource.
+	jiraIssueFieldConfigurationItemResourceModelV1 struct {
+		ID                      types.String                       `tfsdk:"id"`
+		IssueFieldConfiguration types.String                       `tfsdk:"issue_field_configuration"`
+		Item                    *jiraIssueFieldConfigurationItemV1 `tfsdk:"item"`
+	}
+
+	jiraIssueFieldConfigurationItemV1 struct {
+		ID          types.String `tfsdk:"id"`
+		Description types.String `tfsdk:"description"`
+		IsHidden    types.Bool   `tfsdk:"is_hidden"`
+		IsRequired  types.Bool   `tfsdk:"is_required"`
+		Renderer    types.String `tfsdk:"renderer"`
+	}
 )
 
 var (
-	_                   resource.Resource                = (*jiraIssueFieldConfigurationItemResource)(nil)
-	_                   resource.ResourceWithImportState = (*jiraIssueFieldConfigurationItemResource)(nil)
-	renderableItemTypes                                  = []string{"string", "comments-page"}
+	_                   resource.Resource                 = (*jiraIssueFieldConfigurationItemResource)(nil)
+	_                   resource.ResourceWithImportState  = (*jiraIssueFieldConfigurationItemResource)(nil)
+	_                   resource.ResourceWithUpgradeState = (*jiraIssueFieldConfigurationItemResource)(nil)
+	renderableItemTypes                                   = []string{"string", "comments-page"}
 )
 
 func NewJiraIssueFieldConfigurationItemResource() resource.Resource {
@@ -57,12 +75,12 @@ func (*jiraIssueFieldConfigurationItemResource) Metadata(ctx context.Context, re
 
 func (*jiraIssueFieldConfigurationItemResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Version:             1,
+		Version:             2,
 		MarkdownDescription: "Jira Issue Field Configuration Item Resource",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				MarkdownDescription: "The ID of the issue field configuration item. " +
-					"It is computed using `issue_field_configuration` and `item.id` separated by a hyphen (`-`).",
+					"It is computed using `issue_field_configuration` and `item.id` separated by a comma (`,`).",
 				Computed: true,
 			},
 			"issue_field_configuration": schema.StringAttribute{
@@ -114,6 +132,7 @@ func (*jiraIssueFieldConfigurationItemResource) Schema(_ context.Context, _ reso
 					},
 				},
 			},
+			"site": resourceSiteAttribute(),
 		},
 	}
 }
@@ -124,28 +143,76 @@ func (r *jiraIssueFieldConfigurationItemResource) Configure(ctx context.Context,
 		return
 	}
 
-	client, ok := req.ProviderData.(*jira.Client)
+	p, ok := req.ProviderData.(*atlassianProvider)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *jira.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *atlassianProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	r.p.jira = client
+	r.p = *p
 }
 
-func (*jiraIssueFieldConfigurationItemResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, ",")
-	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-		resp.Diagnostics.AddError("Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: issue_field_configuration, item.id. Got: %q", req.ID))
-		return
-	}
+func (r *jiraIssueFieldConfigurationItemResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	r.p.importState(ctx, req, resp,
+		importAttribute{path.Root("issue_field_configuration"), true},
+		importAttribute{path.Root("item").AtName("id"), false},
+	)
+}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("issue_field_configuration"), idParts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("item").AtName("id"), idParts[1])...)
+func (r *jiraIssueFieldConfigurationItemResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		// Version 1 separated the IDs in the ID by a hyphen
+		1: {
+			PriorSchema: &schema.Schema{
+				Version: 1,
+				Attributes: map[string]schema.Attribute{
+					"id":                        schema.StringAttribute{Computed: true},
+					"issue_field_configuration": schema.StringAttribute{Required: true},
+					"item": schema.SingleNestedAttribute{
+						Required: true,
+						Attributes: map[string]schema.Attribute{
+							"id":          schema.StringAttribute{Required: true},
+							"description": schema.StringAttribute{Computed: true, Optional: true},
+							"is_hidden":   schema.BoolAttribute{Computed: true, Optional: true},
+							"is_required": schema.BoolAttribute{Computed: true, Optional: true},
+							"renderer":    schema.StringAttribute{Computed: true, Optional: true},
+						},
+					},
+				},
+			},
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				var priorState jiraIssueFieldConfigurationItemResourceModelV1
+				resp.Diagnostics.Append(req.State.Get(ctx, &priorState)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				upgradedState := jiraIssueFieldConfigurationItemResourceModel{
+					IssueFieldConfiguration: priorState.IssueFieldConfiguration,
+					Site:                    types.StringNull(),
+				}
+				if priorState.Item != nil {
+					upgradedState.ID = types.StringValue(compositeID(priorState.IssueFieldConfiguration.ValueString(), priorState.Item.ID.ValueString()))
+					upgradedState.Item = &jiraIssueFieldConfigurationItem{
+						ID:          priorState.Item.ID,
+						Description: priorState.Item.Description,
+						IsHidden:    priorState.Item.IsHidden,
+						IsRequired:  priorState.Item.IsRequired,
+						Renderer:    priorState.Item.Renderer,
+					}
+				} else {
+					upgradedState.ID = priorState.ID
+				}
+				tflog.Debug(ctx, "Upgraded issue field configuration item state from schema version 1", map[string]interface{}{
+					"id": upgradedState.ID.ValueString(),
+				})
+				resp.Diagnostics.Append(resp.State.Set(ctx, upgradedState)...)
+			},
+		},
+	}
 }
 
 func (r *jiraIssueFieldConfigurationItemResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -156,14 +223,14 @@ func (r *jiraIssueFieldConfigurationItemResource) Create(ctx context.Context, re
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	ctx = withSite(ctx, plan.Site)
 	tflog.Debug(ctx, "Loaded issue field configuration item plan", map[string]interface{}{
 		"createPlan": fmt.Sprintf("%+v, %+v", plan, *plan.Item),
 	})
 
 	if !plan.Item.Renderer.IsNull() && !plan.Item.Renderer.IsUnknown() {
-		err := r.checkIssueFieldConfigurationItemRenderable(ctx, &plan)
-		if err != nil {
-			resp.Diagnostics.Append(err)
+		resp.Diagnostics.Append(r.checkIssueFieldConfigurationItemRenderable(ctx, &plan)...)
+		if resp.Diagnostics.HasError() {
 			return
 		}
 	}
@@ -183,38 +250,28 @@ func (r *jiraIssueFieldConfigurationItemResource) Create(ctx context.Context, re
 
 	res, err := r.p.jira.Issue.Field.Configuration.Item.Update(ctx, issueFieldConfigurationId, &createRequestPayload)
 	if err != nil {
-		var resBody string
-		if res != nil {
-			resBody = res.Bytes.String()
-		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create issue field configuration item, got error: %s\n%s", err, resBody))
+		resp.Diagnostics.Append(clientErrorDiagnostics("create issue field configuration item", res, err)...)
 		return
 	}
 
-	items, res, err := r.p.jira.Issue.Field.Configuration.Item.Gets(ctx, issueFieldConfigurationId, 0, 50)
+	item, res, err := r.issueFieldConfigurationItem(ctx, issueFieldConfigurationId, plan.Item.ID.ValueString())
 	if err != nil {
-		var resBody string
-		if res != nil {
-			resBody = res.Bytes.String()
-		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get issue field configuration items, got error: %s\n%s", err, resBody))
+		resp.Diagnostics.Append(clientErrorDiagnostics("get issue field configuration items", res, err)...)
 		return
 	}
 
-	for _, i := range items.Values {
-		if i.ID == plan.Item.ID.ValueString() {
-			plan.Item = &jiraIssueFieldConfigurationItem{
-				ID:          types.StringValue(plan.Item.ID.ValueString()),
-				Description: types.StringValue(i.Description),
-				IsHidden:    types.BoolValue(i.IsHidden),
-				IsRequired:  types.BoolValue(i.IsRequired),
-				Renderer:    types.StringValue(i.Renderer),
-			}
+	if item != nil {
+		plan.Item = &jiraIssueFieldConfigurationItem{
+			ID:          types.StringValue(plan.Item.ID.ValueString()),
+			Description: types.StringValue(item.Description),
+			IsHidden:    types.BoolValue(item.IsHidden),
+			IsRequired:  types.BoolValue(item.IsRequired),
+			Renderer:    types.StringValue(item.Renderer),
 		}
 	}
 	tflog.Debug(ctx, "Created issue field configuration item")
 
-	plan.ID = types.StringValue(fmt.Sprintf("%s-%s", plan.IssueFieldConfiguration.ValueString(), plan.Item.ID.ValueString()))
+	plan.ID = types.StringValue(compositeID(plan.IssueFieldConfiguration.ValueString(), plan.Item.ID.ValueString()))
 
 	tflog.Debug(ctx, "Storing issue field configuration item info into the state", map[string]interface{}{
 		"createNewState": fmt.Sprintf("%+v, %+v", plan, *plan.Item),
@@ -230,35 +287,35 @@ func (r *jiraIssueFieldConfigurationItemResource) Read(ctx context.Context, req
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	ctx = withSite(ctx, state.Site)
 	tflog.Debug(ctx, "Loaded issue field configuration item from state", map[string]interface{}{
 		"readState": fmt.Sprintf("%+v, %+v", state, *state.Item),
 	})
 
 	issueFieldConfigurationId, _ := strconv.Atoi(state.IssueFieldConfiguration.ValueString())
-	issueFieldConfigurationItem, res, err := r.p.jira.Issue.Field.Configuration.Item.Gets(ctx, issueFieldConfigurationId, 0, 50)
+	item, res, err := r.issueFieldConfigurationItem(ctx, issueFieldConfigurationId, state.Item.ID.ValueString())
 	if err != nil {
-		var resBody string
-		if res != nil {
-			resBody = res.Bytes.String()
+		if isNotFound(res) {
+			removeNotFoundResource(ctx, resp, "issue field configuration item")
+			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get issue field configuration item, got error: %s\n%s", err, resBody))
+		resp.Diagnostics.Append(clientErrorDiagnostics("get issue field configuration item", res, err)...)
 		return
 	}
-
-	for _, i := range issueFieldConfigurationItem.Values {
-		if i.ID == state.Item.ID.ValueString() {
-			state.Item = &jiraIssueFieldConfigurationItem{
-				ID:          types.StringValue(state.Item.ID.ValueString()),
-				Description: types.StringValue(i.Description),
-				IsHidden:    types.BoolValue(i.IsHidden),
-				IsRequired:  types.BoolValue(i.IsRequired),
-				Renderer:    types.StringValue(i.Renderer),
-			}
-		}
+	if item == nil {
+		removeNotFoundResource(ctx, resp, "issue field configuration item")
+		return
+	}
+	state.Item = &jiraIssueFieldConfigurationItem{
+		ID:          types.StringValue(state.Item.ID.ValueString()),
+		Description: types.StringValue(item.Description),
+		IsHidden:    types.BoolValue(item.IsHidden),
+		IsRequired:  types.BoolValue(item.IsRequired),
+		Renderer:    types.StringValue(item.Renderer),
 	}
 	tflog.Debug(ctx, "Retrieved issue field configuration item from API state")
 
-	state.ID = types.StringValue(fmt.Sprintf("%s-%s", state.IssueFieldConfiguration.ValueString(), state.Item.ID.ValueString()))
+	state.ID = types.StringValue(compositeID(state.IssueFieldConfiguration.ValueString(), state.Item.ID.ValueString()))
 
 	tflog.Debug(ctx, "Storing issue field configuration item into the state", map[string]interface{}{
 		"readNewState": fmt.Sprintf("%+v, %+v", state, *state.Item),
@@ -274,6 +331,7 @@ func (r *jiraIssueFieldConfigurationItemResource) Update(ctx context.Context, re
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	ctx = withSite(ctx, plan.Site)
 	tflog.Debug(ctx, "Loaded issue field configuration item plan", map[string]interface{}{
 		"updatePlan": fmt.Sprintf("%+v, %+v", plan, *plan.Item),
 	})
@@ -302,33 +360,23 @@ func (r *jiraIssueFieldConfigurationItemResource) Update(ctx context.Context, re
 	issueFieldConfigurationId, _ := strconv.Atoi(plan.IssueFieldConfiguration.ValueString())
 	res, err := r.p.jira.Issue.Field.Configuration.Item.Update(ctx, issueFieldConfigurationId, &updateRequestPayload)
 	if err != nil {
-		var resBody string
-		if err != nil {
-			resBody = res.Bytes.String()
-		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update issue field configuration item, got error: %s\n%s", err, resBody))
+		resp.Diagnostics.Append(clientErrorDiagnostics("update issue field configuration item", res, err)...)
 		return
 	}
 
-	items, res, err := r.p.jira.Issue.Field.Configuration.Item.Gets(ctx, issueFieldConfigurationId, 0, 50)
+	item, res, err := r.issueFieldConfigurationItem(ctx, issueFieldConfigurationId, plan.Item.ID.ValueString())
 	if err != nil {
-		var resBody string
-		if res != nil {
-			resBody = res.Bytes.String()
-		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get issue field configuration items, got error: %s\n%s", err, resBody))
+		resp.Diagnostics.Append(clientErrorDiagnostics("get issue field configuration items", res, err)...)
 		return
 	}
 
-	for _, i := range items.Values {
-		if i.ID == plan.Item.ID.ValueString() {
-			plan.Item = &jiraIssueFieldConfigurationItem{
-				ID:          types.StringValue(plan.Item.ID.ValueString()),
-				Description: types.StringValue(i.Description),
-				IsHidden:    types.BoolValue(i.IsHidden),
-				IsRequired:  types.BoolValue(i.IsRequired),
-				Renderer:    types.StringValue(i.Renderer),
-			}
+	if item != nil {
+		plan.Item = &jiraIssueFieldConfigurationItem{
+			ID:          types.StringValue(plan.Item.ID.ValueString()),
+			Description: types.StringValue(item.Description),
+			IsHidden:    types.BoolValue(item.IsHidden),
+			IsRequired:  types.BoolValue(item.IsRequired),
+			Renderer:    types.StringValue(item.Renderer),
 		}
 	}
 
@@ -345,7 +393,29 @@ func (r *jiraIssueFieldConfigurationItemResource) Delete(ctx context.Context, re
 	// If a Resource type Delete method is completed without error, the framework will automatically remove the resource.
 }
 
-func (r *jiraIssueFieldConfigurationItemResource) checkIssueFieldConfigurationItemRenderable(ctx context.Context, p *jiraIssueFieldConfigurationItemResourceModel) diag.Diagnostic {
+// issueFieldConfigurationItem returns the item of the issue field configuration with the given ID,
+// or nil when the issue field configuration has no such item. The items are read page by page
+// until the item is found, as issue field configurations of large sites have many items.
+func (r *jiraIssueFieldConfigurationItemResource) issueFieldConfigurationItem(ctx context.Context, issueFieldConfigurationId int, itemId string) (*models.FieldConfigurationItemScheme, *models.ResponseScheme, error) {
+	isLast := false
+	startAt := 0
+	for !isLast {
+		page, res, err := r.p.jira.Issue.Field.Configuration.Item.Gets(ctx, issueFieldConfigurationId, startAt, jiraListPageSize)
+		if err != nil {
+			return nil, res, err
+		}
+		for _, i := range page.Values {
+			if i.ID == itemId {
+				return i, res, nil
+			}
+		}
+		startAt += jiraListPageSize
+		isLast = page.IsLast || len(page.Values) == 0
+	}
+	return nil, nil, nil
+}
+
+func (r *jiraIssueFieldConfigurationItemResource) checkIssueFieldConfigurationItemRenderable(ctx context.Context, p *jiraIssueFieldConfigurationItemResourceModel) diag.Diagnostics {
 	var isRenderable bool
 	searchPayload := models.FieldSearchOptionsScheme{
 		IDs:    []string{p.Item.ID.ValueString()},
@@ -354,27 +424,26 @@ func (r *jiraIssueFieldConfigurationItemResource) checkIssueFieldConfigurationIt
 
 	itemDetails, res, err := r.p.jira.Issue.Field.Search(ctx, &searchPayload, 0, 1)
 	if err != nil {
-		var resBody string
-		if res != nil {
-			resBody = res.Bytes.String()
-		}
-		return diag.NewAttributeErrorDiagnostic(path.Root("item").AtName("id"), "User Error", fmt.Sprintf(" Unable to find issue field configuration item, got error: %s\n%s", err, resBody))
+		return clientErrorAttributeDiagnostics(path.Root("item").AtName("id"), "find issue field configuration item", res, err)
+	}
+	if len(itemDetails.Values) == 0 {
+		return diag.Diagnostics{diag.NewAttributeErrorDiagnostic(path.Root("item").AtName("id"), "User Error", fmt.Sprintf(" Unable to find issue field configuration item with ID: [%s]", p.Item.ID.ValueString()))}
 	}
 	tflog.Debug(ctx, "Found issue field configuration item details", map[string]interface{}{
 		"issueFieldConfigurationItem": fmt.Sprintf("%+v, %+v", itemDetails.Values[0], itemDetails.Values[0].Schema),
 	})
 
 	if itemDetails.Values[0].ID != p.Item.ID.ValueString() {
-		return diag.NewAttributeErrorDiagnostic(path.Root("item").AtName("id"), "User Error", fmt.Sprintf(" Search result does not match issue field configuration item with ID: [%s]", p.Item.ID.ValueString()))
+		return diag.Diagnostics{diag.NewAttributeErrorDiagnostic(path.Root("item").AtName("id"), "User Error", fmt.Sprintf(" Search result does not match issue field configuration item with ID: [%s]", p.Item.ID.ValueString()))}
 	}
 
 	if itemDetails.Values[0].IsLocked {
-		return diag.NewAttributeErrorDiagnostic(path.Root("item").AtName("id"), "User Error", fmt.Sprintf(" Tried to set a renderer for the locked item with ID: [%s]", p.Item.ID.ValueString()))
+		return diag.Diagnostics{diag.NewAttributeErrorDiagnostic(path.Root("item").AtName("id"), "User Error", fmt.Sprintf(" Tried to set a renderer for the locked item with ID: [%s]", p.Item.ID.ValueString()))}
 	}
 
 	isRenderable = strings.Contains(strings.Join(renderableItemTypes, ","), itemDetails.Values[0].Schema.Type)
 	if !isRenderable {
-		return diag.NewAttributeErrorDiagnostic(path.Root("item").AtName("id"), "User Error", fmt.Sprintf(" Tried to set a renderer for the non-renderable item with ID: [%s]", p.Item.ID.ValueString()))
+		return diag.Diagnostics{diag.NewAttributeErrorDiagnostic(path.Root("item").AtName("id"), "User Error", fmt.Sprintf(" Tried to set a renderer for the non-renderable item with ID: [%s]", p.Item.ID.ValueString()))}
 	}
 
 	return nil
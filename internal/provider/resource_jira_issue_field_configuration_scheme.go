@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"strconv"
 
-	jira "github.com/ctreminiom/go-atlassian/jira/v3"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -26,12 +25,18 @@ type (
 		ID          types.String `tfsdk:"id"`
 		Name        types.String `tfsdk:"name"`
 		Description types.String `tfsdk:"description"`
+
+		DeletionProtection types.Bool `tfsdk:"deletion_protection"`
+		ForceDetach        types.Bool `tfsdk:"force_detach"`
+
+		Site types.String `tfsdk:"site"`
 	}
 )
 
 var (
 	_ resource.Resource                = (*jiraIssueFieldConfigurationSchemeResource)(nil)
 	_ resource.ResourceWithImportState = (*jiraIssueFieldConfigurationSchemeResource)(nil)
+	_ resource.ResourceWithModifyPlan  = (*jiraIssueFieldConfigurationSchemeResource)(nil)
 )
 
 func NewJiraIssueFieldConfigurationSchemeResource() resource.Resource {
@@ -72,6 +77,9 @@ func (*jiraIssueFieldConfigurationSchemeResource) Schema(_ context.Context, _ re
 					stringmodifiers.DefaultValue(""),
 				},
 			},
+			"deletion_protection": deletionProtectionAttribute("issue field configuration scheme"),
+			"force_detach":        forceDetachAttribute("issue field configuration scheme"),
+			"site":                resourceSiteAttribute(),
 		},
 	}
 }
@@ -82,20 +90,39 @@ func (r *jiraIssueFieldConfigurationSchemeResource) Configure(ctx context.Contex
 		return
 	}
 
-	client, ok := req.ProviderData.(*jira.Client)
+	p, ok := req.ProviderData.(*atlassianProvider)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *jira.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *atlassianProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	r.p.jira = client
+	r.p = *p
+}
+
+func (r *jiraIssueFieldConfigurationSchemeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	r.p.importState(ctx, req, resp, importAttribute{path.Root("id"), true})
 }
 
-func (*jiraIssueFieldConfigurationSchemeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+func (r *jiraIssueFieldConfigurationSchemeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Replacing the issue field configuration scheme deletes it first
+	resp.Diagnostics.Append(replacePlanDiagnostics(ctx, "issue field configuration scheme", req, resp)...)
+
+	// Otherwise, only the plans to destroy the issue field configuration scheme are checked
+	if !req.Plan.Raw.IsNull() || req.State.Raw.IsNull() || r.p.jira == nil {
+		return
+	}
+
+	var state jiraIssueFieldConfigurationSchemeResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx = withSite(ctx, state.Site)
+
+	resp.Diagnostics.Append(destroyPlanDiagnostics(ctx, "issue field configuration scheme", state.Name.ValueString(), state.DeletionProtection.ValueBool(), state.ForceDetach.ValueBool(), jiraIssueFieldConfigurationSchemeType.usage(r.p.jira, state.ID.ValueString()))...)
 }
 
 func (r *jiraIssueFieldConfigurationSchemeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -106,17 +133,14 @@ func (r *jiraIssueFieldConfigurationSchemeResource) Create(ctx context.Context,
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	ctx = withSite(ctx, plan.Site)
 	tflog.Debug(ctx, "Loaded issue field configuration scheme plan", map[string]interface{}{
 		"createPlan": fmt.Sprintf("%+v", plan),
 	})
 
-	issueFieldConfigurationScheme, res, err := r.p.jira.Issue.Field.Configuration.Scheme.Create(ctx, plan.Name.ValueString(), plan.Description.ValueString())
+	issueFieldConfigurationScheme, res, err := r.p.jira.Issue.Field.Configuration.Scheme.Create(ctx, r.p.prefixedName(plan.Name.ValueString()), r.p.suffixedDescription(plan.Description.ValueString()))
 	if err != nil {
-		var resBody string
-		if res != nil {
-			resBody = res.Bytes.String()
-		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create issue field configuration scheme, got error: %s\n%s", err, resBody))
+		resp.Diagnostics.Append(clientErrorDiagnostics("create issue field configuration scheme", res, err)...)
 		return
 	}
 	tflog.Debug(ctx, "Created issue field configuration scheme")
@@ -137,6 +161,7 @@ func (r *jiraIssueFieldConfigurationSchemeResource) Read(ctx context.Context, re
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	ctx = withSite(ctx, state.Site)
 	tflog.Debug(ctx, "Loaded issue field configuration scheme from state", map[string]interface{}{
 		"readState": fmt.Sprintf("%+v", state),
 	})
@@ -144,17 +169,21 @@ func (r *jiraIssueFieldConfigurationSchemeResource) Read(ctx context.Context, re
 	id, _ := strconv.Atoi(state.ID.ValueString())
 	issueFieldConfigurationScheme, res, err := r.p.jira.Issue.Field.Configuration.Scheme.Gets(ctx, []int{id}, 0, 1)
 	if err != nil {
-		var resBody string
-		if res != nil {
-			resBody = res.Bytes.String()
+		if isNotFound(res) {
+			removeNotFoundResource(ctx, resp, "issue field configuration scheme")
+			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get issue field configuration scheme, got error: %s\n%s", err, resBody))
+		resp.Diagnostics.Append(clientErrorDiagnostics("get issue field configuration scheme", res, err)...)
+		return
+	}
+	if len(issueFieldConfigurationScheme.Values) == 0 {
+		removeNotFoundResource(ctx, resp, "issue field configuration scheme")
 		return
 	}
 	tflog.Debug(ctx, "Retrieved issue field configuration scheme from API state")
 
-	state.Name = types.StringValue(issueFieldConfigurationScheme.Values[0].Name)
-	state.Description = types.StringValue(issueFieldConfigurationScheme.Values[0].Description)
+	state.Name = types.StringValue(r.p.unprefixedName(issueFieldConfigurationScheme.Values[0].Name))
+	state.Description = types.StringValue(r.p.unsuffixedDescription(issueFieldConfigurationScheme.Values[0].Description))
 
 	tflog.Debug(ctx, "Storing issue field configuration scheme info into the state", map[string]interface{}{
 		"readNewState": fmt.Sprintf("%+v", state),
@@ -170,6 +199,7 @@ func (r *jiraIssueFieldConfigurationSchemeResource) Update(ctx context.Context,
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	ctx = withSite(ctx, plan.Site)
 	tflog.Debug(ctx, "Loaded issue field configuration scheme plan", map[string]interface{}{
 		"updatePlan": fmt.Sprintf("%+v", plan),
 	})
@@ -184,13 +214,9 @@ func (r *jiraIssueFieldConfigurationSchemeResource) Update(ctx context.Context,
 	})
 
 	id, _ := strconv.Atoi(state.ID.ValueString())
-	res, err := r.p.jira.Issue.Field.Configuration.Scheme.Update(ctx, id, plan.Name.ValueString(), plan.Description.ValueString())
+	res, err := r.p.jira.Issue.Field.Configuration.Scheme.Update(ctx, id, r.p.prefixedName(plan.Name.ValueString()), r.p.suffixedDescription(plan.Description.ValueString()))
 	if err != nil {
-		var resBody string
-		if res != nil {
-			resBody = res.Bytes.String()
-		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update issue field configuration scheme, got error: %s\n%s", err, resBody))
+		resp.Diagnostics.Append(clientErrorDiagnostics("update issue field configuration scheme", res, err)...)
 		return
 	}
 	tflog.Debug(ctx, "Updated issue field configuration scheme")
@@ -210,15 +236,22 @@ func (r *jiraIssueFieldConfigurationSchemeResource) Delete(ctx context.Context,
 		return
 	}
 	tflog.Debug(ctx, "Loaded issue field configuration scheme from state")
+	ctx = withSite(ctx, state.Site)
+
+	if state.DeletionProtection.ValueBool() {
+		resp.Diagnostics.Append(deletionProtectionDiagnostics("issue field configuration scheme", state.Name.ValueString())...)
+		return
+	}
+
+	resp.Diagnostics.Append(jiraIssueFieldConfigurationSchemeType.detachProjects(ctx, r.p.jira, state.ID.ValueString(), state.Name.ValueString(), state.ForceDetach.ValueBool())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	id, _ := strconv.Atoi(state.ID.ValueString())
 	res, err := r.p.jira.Issue.Field.Configuration.Scheme.Delete(ctx, id)
 	if err != nil {
-		var resBody string
-		if res != nil {
-			resBody = res.Bytes.String()
-		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete issue field configuration scheme, got error: %s\n%s", err, resBody))
+		resp.Diagnostics.Append(clientErrorDiagnostics("delete issue field configuration scheme", res, err)...)
 		return
 	}
 	tflog.Debug(ctx, "Deleted issue field configuration scheme from API state")
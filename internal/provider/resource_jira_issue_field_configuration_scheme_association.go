@@ -0,0 +1,218 @@
+package atlassian
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+type (
+	jiraIssueFieldConfigurationSchemeAssociationResource struct {
+		p atlassianProvider
+	}
+
+	jiraIssueFieldConfigurationSchemeAssociationResourceModel struct {
+		ID                         types.String `tfsdk:"id"`
+		ProjectID                  types.String `tfsdk:"project_id"`
+		FieldConfigurationSchemeID types.String `tfsdk:"field_configuration_scheme_id"`
+
+		Site types.String `tfsdk:"site"`
+	}
+)
+
+var (
+	_ resource.Resource                = (*jiraIssueFieldConfigurationSchemeAssociationResource)(nil)
+	_ resource.ResourceWithImportState = (*jiraIssueFieldConfigurationSchemeAssociationResource)(nil)
+)
+
+func NewJiraIssueFieldConfigurationSchemeAssociationResource() resource.Resource {
+	return &jiraIssueFieldConfigurationSchemeAssociationResource{}
+}
+
+func (*jiraIssueFieldConfigurationSchemeAssociationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_jira_issue_field_configuration_scheme_association"
+}
+
+func (*jiraIssueFieldConfigurationSchemeAssociationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version:             1,
+		MarkdownDescription: "Jira Issue Field Configuration Scheme Association Resource",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the issue field configuration scheme association. " +
+					"It is computed using `project_id` and `field_configuration_scheme_id` separated by a comma (`,`).",
+				Computed: true,
+			},
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "(Forces new resource) The ID of the project.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"field_configuration_scheme_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the issue field configuration scheme to assign to the project. " +
+					"On destroy, the default issue field configuration scheme is assigned to the project.",
+				Required: true,
+			},
+			"site": resourceSiteAttribute(),
+		},
+	}
+}
+
+func (r *jiraIssueFieldConfigurationSchemeAssociationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	p, ok := req.ProviderData.(*atlassianProvider)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *atlassianProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.p = *p
+}
+
+func (r *jiraIssueFieldConfigurationSchemeAssociationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	r.p.importState(ctx, req, resp,
+		importAttribute{path.Root("project_id"), true},
+		importAttribute{path.Root("field_configuration_scheme_id"), true},
+	)
+}
+
+func (r *jiraIssueFieldConfigurationSchemeAssociationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Creating issue field configuration scheme association resource")
+
+	var plan jiraIssueFieldConfigurationSchemeAssociationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx = withSite(ctx, plan.Site)
+	tflog.Debug(ctx, "Loaded issue field configuration scheme association plan", map[string]interface{}{
+		"createPlan": fmt.Sprintf("%+v", plan),
+	})
+
+	payload := &models.FieldConfigurationSchemeAssignPayload{
+		FieldConfigurationSchemeID: plan.FieldConfigurationSchemeID.ValueString(),
+		ProjectID:                  plan.ProjectID.ValueString(),
+	}
+	res, err := r.p.jira.Issue.Field.Configuration.Scheme.Assign(ctx, payload)
+	if err != nil {
+		resp.Diagnostics.Append(clientErrorDiagnostics("assign issue field configuration scheme to project", res, err)...)
+		return
+	}
+	tflog.Debug(ctx, "Created issue field configuration scheme association")
+
+	plan.ID = types.StringValue(compositeID(plan.ProjectID.ValueString(), plan.FieldConfigurationSchemeID.ValueString()))
+
+	tflog.Debug(ctx, "Storing issue field configuration scheme association into the state", map[string]interface{}{
+		"createNewState": fmt.Sprintf("%+v", plan),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *jiraIssueFieldConfigurationSchemeAssociationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Reading issue field configuration scheme association resource")
+
+	var state jiraIssueFieldConfigurationSchemeAssociationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx = withSite(ctx, state.Site)
+	tflog.Debug(ctx, "Loaded issue field configuration scheme association from state", map[string]interface{}{
+		"readState": fmt.Sprintf("%+v", state),
+	})
+
+	projectId, _ := strconv.Atoi(state.ProjectID.ValueString())
+	projectFieldConfigurationSchemes, res, err := r.p.jira.Issue.Field.Configuration.Scheme.Project(ctx, []int{projectId}, 0, 1)
+	if err != nil {
+		if isNotFound(res) {
+			removeNotFoundResource(ctx, resp, "issue field configuration scheme association")
+			return
+		}
+		resp.Diagnostics.Append(clientErrorDiagnostics("get project issue field configuration scheme", res, err)...)
+		return
+	}
+	tflog.Debug(ctx, "Retrieved issue field configuration scheme association from API state")
+
+	// Projects using the default issue field configuration scheme are returned without a scheme
+	state.FieldConfigurationSchemeID = types.StringValue("")
+	for _, v := range projectFieldConfigurationSchemes.Values {
+		if v.FieldConfigurationScheme != nil {
+			state.FieldConfigurationSchemeID = types.StringValue(v.FieldConfigurationScheme.ID)
+		}
+	}
+	state.ID = types.StringValue(compositeID(state.ProjectID.ValueString(), state.FieldConfigurationSchemeID.ValueString()))
+
+	tflog.Debug(ctx, "Storing issue field configuration scheme association into the state", map[string]interface{}{
+		"readNewState": fmt.Sprintf("%+v", state),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *jiraIssueFieldConfigurationSchemeAssociationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Updating issue field configuration scheme association resource")
+
+	var plan jiraIssueFieldConfigurationSchemeAssociationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx = withSite(ctx, plan.Site)
+	tflog.Debug(ctx, "Loaded issue field configuration scheme association plan", map[string]interface{}{
+		"updatePlan": fmt.Sprintf("%+v", plan),
+	})
+
+	payload := &models.FieldConfigurationSchemeAssignPayload{
+		FieldConfigurationSchemeID: plan.FieldConfigurationSchemeID.ValueString(),
+		ProjectID:                  plan.ProjectID.ValueString(),
+	}
+	res, err := r.p.jira.Issue.Field.Configuration.Scheme.Assign(ctx, payload)
+	if err != nil {
+		resp.Diagnostics.Append(clientErrorDiagnostics("assign issue field configuration scheme to project", res, err)...)
+		return
+	}
+	tflog.Debug(ctx, "Updated issue field configuration scheme association in API state")
+
+	plan.ID = types.StringValue(compositeID(plan.ProjectID.ValueString(), plan.FieldConfigurationSchemeID.ValueString()))
+
+	tflog.Debug(ctx, "Storing issue field configuration scheme association into the state")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *jiraIssueFieldConfigurationSchemeAssociationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Deleting issue field configuration scheme association resource")
+
+	var state jiraIssueFieldConfigurationSchemeAssociationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded issue field configuration scheme association from state")
+	ctx = withSite(ctx, state.Site)
+
+	res, err := assignDefaultProjectScheme(ctx, r.p.jira, "rest/api/3/fieldconfigurationscheme/project", "fieldConfigurationSchemeId", state.ProjectID.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(clientErrorDiagnostics("assign default issue field configuration scheme to project", res, err)...)
+		return
+	}
+	tflog.Debug(ctx, "Deleted issue field configuration scheme association from API state")
+
+	// If a Resource type Delete method is completed without error, the framework will automatically remove the resource.
+}
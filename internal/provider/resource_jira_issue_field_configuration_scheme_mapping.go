@@ -4,9 +4,7 @@ import (
 	"context"
 	"fmt"
 	"strconv"
-	"strings"
 
-	jira "github.com/ctreminiom/go-atlassian/jira/v3"
 	"github.com/ctreminiom/go-atlassian/pkg/infra/models"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -27,12 +25,24 @@ type (
 		FieldConfigurationSchemeID types.String `tfsdk:"field_configuration_scheme_id"`
 		FieldConfigurationID       types.String `tfsdk:"field_configuration_id"`
 		IssueTypeID                types.String `tfsdk:"issue_type_id"`
+
+		Site types.String `tfsdk:"site"`
+	}
+
+	// jiraIssueFieldConfigurationSchemeMappingResourceModelV1 is the model of the schema version 1 of
+	// the resource.
+	jiraIssueFieldConfigurationSchemeMappingResourceModelV1 struct {
+		ID                         types.String `tfsdk:"id"`
+		FieldConfigurationSchemeID types.String `tfsdk:"field_configuration_scheme_id"`
+		FieldConfigurationID       types.String `tfsdk:"field_configuration_id"`
+		IssueTypeID                types.String `tfsdk:"issue_type_id"`
 	}
 )
 
 var (
-	_ resource.Resource                = (*jiraIssueFieldConfigurationSchemeMappingResource)(nil)
-	_ resource.ResourceWithImportState = (*jiraIssueFieldConfigurationSchemeMappingResource)(nil)
+	_ resource.Resource                 = (*jiraIssueFieldConfigurationSchemeMappingResource)(nil)
+	_ resource.ResourceWithImportState  = (*jiraIssueFieldConfigurationSchemeMappingResource)(nil)
+	_ resource.ResourceWithUpgradeState = (*jiraIssueFieldConfigurationSchemeMappingResource)(nil)
 )
 
 func NewJiraIssueFieldConfigurationSchemeMappingResource() resource.Resource {
@@ -45,12 +55,12 @@ func (*jiraIssueFieldConfigurationSchemeMappingResource) Metadata(ctx context.Co
 
 func (*jiraIssueFieldConfigurationSchemeMappingResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Version:             1,
+		Version:             2,
 		MarkdownDescription: "Jira Issue Field Configuration Scheme Mapping Resource",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				MarkdownDescription: "The ID of the issue field configuration scheme mapping. " +
-					"It is computed using `field_configuration_scheme_id`, `field_configuration_id` and `issue_type_id` separated by a hyphen (`-`).",
+					"It is computed using `field_configuration_scheme_id`, `field_configuration_id` and `issue_type_id` separated by a comma (`,`).",
 				Computed: true,
 			},
 			"field_configuration_scheme_id": schema.StringAttribute{
@@ -75,6 +85,7 @@ func (*jiraIssueFieldConfigurationSchemeMappingResource) Schema(_ context.Contex
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"site": resourceSiteAttribute(),
 		},
 	}
 }
@@ -85,30 +96,60 @@ func (r *jiraIssueFieldConfigurationSchemeMappingResource) Configure(ctx context
 		return
 	}
 
-	client, ok := req.ProviderData.(*jira.Client)
+	p, ok := req.ProviderData.(*atlassianProvider)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *jira.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *atlassianProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	r.p.jira = client
+	r.p = *p
 }
 
-func (*jiraIssueFieldConfigurationSchemeMappingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, ",")
-	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
-		resp.Diagnostics.AddError("Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: field_configuration_scheme_id, field_configuration_id, issue_type_id. Got: %q", req.ID))
-		return
-	}
-	tflog.Debug(ctx, fmt.Sprintf("Importing issue field configuration scheme mapping with import identifier: %+v", idParts))
+func (r *jiraIssueFieldConfigurationSchemeMappingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	r.p.importState(ctx, req, resp,
+		importAttribute{path.Root("field_configuration_scheme_id"), true},
+		importAttribute{path.Root("field_configuration_id"), true},
+		importAttribute{path.Root("issue_type_id"), false},
+	)
+}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("field_configuration_scheme_id"), idParts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("field_configuration_id"), idParts[1])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("issue_type_id"), idParts[2])...)
+func (r *jiraIssueFieldConfigurationSchemeMappingResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		// Version 1 separated the IDs in the ID by a hyphen
+		1: {
+			PriorSchema: &schema.Schema{
+				Version: 1,
+				Attributes: map[string]schema.Attribute{
+					"id":                            schema.StringAttribute{Computed: true},
+					"field_configuration_scheme_id": schema.StringAttribute{Required: true},
+					"field_configuration_id":        schema.StringAttribute{Required: true},
+					"issue_type_id":                 schema.StringAttribute{Required: true},
+				},
+			},
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				var priorState jiraIssueFieldConfigurationSchemeMappingResourceModelV1
+				resp.Diagnostics.Append(req.State.Get(ctx, &priorState)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				upgradedState := jiraIssueFieldConfigurationSchemeMappingResourceModel{
+					ID:                         types.StringValue(compositeID(priorState.FieldConfigurationSchemeID.ValueString(), priorState.FieldConfigurationID.ValueString(), priorState.IssueTypeID.ValueString())),
+					FieldConfigurationSchemeID: priorState.FieldConfigurationSchemeID,
+					FieldConfigurationID:       priorState.FieldConfigurationID,
+					IssueTypeID:                priorState.IssueTypeID,
+					Site:                       types.StringNull(),
+				}
+				tflog.Debug(ctx, "Upgraded issue field configuration scheme mapping state from schema version 1", map[string]interface{}{
+					"id": upgradedState.ID.ValueString(),
+				})
+				resp.Diagnostics.Append(resp.State.Set(ctx, upgradedState)...)
+			},
+		},
+	}
 }
 
 func (r *jiraIssueFieldConfigurationSchemeMappingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -119,6 +160,7 @@ func (r *jiraIssueFieldConfigurationSchemeMappingResource) Create(ctx context.Co
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	ctx = withSite(ctx, plan.Site)
 	tflog.Debug(ctx, "Loaded issue field configuration scheme mapping plan", map[string]interface{}{
 		"createPlan": fmt.Sprintf("%+v", plan),
 	})
@@ -135,16 +177,12 @@ func (r *jiraIssueFieldConfigurationSchemeMappingResource) Create(ctx context.Co
 
 	res, err := r.p.jira.Issue.Field.Configuration.Scheme.Link(ctx, issueFieldConfigurationSchemeId, &createRequestPayload)
 	if err != nil {
-		var resBody string
-		if res != nil {
-			resBody = res.Bytes.String()
-		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create issue field configuration scheme mapping, got error: %s\n%s", err, resBody))
+		resp.Diagnostics.Append(clientErrorDiagnostics("create issue field configuration scheme mapping", res, err)...)
 		return
 	}
 	tflog.Debug(ctx, "Created issue field configuration scheme mapping")
 
-	plan.ID = types.StringValue(fmt.Sprintf("%s-%s-%s", plan.FieldConfigurationSchemeID.ValueString(), plan.FieldConfigurationID.ValueString(), plan.IssueTypeID.ValueString()))
+	plan.ID = types.StringValue(compositeID(plan.FieldConfigurationSchemeID.ValueString(), plan.FieldConfigurationID.ValueString(), plan.IssueTypeID.ValueString()))
 
 	tflog.Debug(ctx, "Storing issue field configuration scheme mapping into the state", map[string]interface{}{
 		"createNewState": fmt.Sprintf("%+v", plan),
@@ -160,40 +198,44 @@ func (r *jiraIssueFieldConfigurationSchemeMappingResource) Read(ctx context.Cont
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	ctx = withSite(ctx, state.Site)
 	tflog.Debug(ctx, "Loaded issue field configuration scheme mapping from state", map[string]interface{}{
 		"readState": fmt.Sprintf("%+v", state),
 	})
 
 	fieldConfigurationSchemeId, _ := strconv.Atoi(state.FieldConfigurationSchemeID.ValueString())
-	mappings, res, err := r.p.jira.Issue.Field.Configuration.Scheme.Mapping(ctx, []int{fieldConfigurationSchemeId}, 0, 50)
-	if err != nil {
-		var resBody string
-		if res != nil {
-			resBody = res.Bytes.String()
-		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get issue field configuration scheme mappings, got error: %s\n%s", err, resBody))
-		return
-	}
-
+	// The mappings are read page by page until the mapping is found
 	found := false
-	for _, m := range mappings.Values {
-		if m.FieldConfigurationSchemeID == state.FieldConfigurationSchemeID.ValueString() {
-			if m.IssueTypeID == state.IssueTypeID.ValueString() && m.FieldConfigurationID == state.FieldConfigurationID.ValueString() {
-				found = true
+	isLast := false
+	startAt := 0
+	for !isLast && !found {
+		mappings, res, err := r.p.jira.Issue.Field.Configuration.Scheme.Mapping(ctx, []int{fieldConfigurationSchemeId}, startAt, jiraListPageSize)
+		if err != nil {
+			if isNotFound(res) {
+				removeNotFoundResource(ctx, resp, "issue field configuration scheme mapping")
+				return
 			}
+			resp.Diagnostics.Append(clientErrorDiagnostics("get issue field configuration scheme mappings", res, err)...)
+			return
 		}
+		for _, m := range mappings.Values {
+			if m.FieldConfigurationSchemeID == state.FieldConfigurationSchemeID.ValueString() {
+				if m.IssueTypeID == state.IssueTypeID.ValueString() && m.FieldConfigurationID == state.FieldConfigurationID.ValueString() {
+					found = true
+				}
+			}
+		}
+		startAt += jiraListPageSize
+		isLast = mappings.IsLast || len(mappings.Values) == 0
 	}
 
 	if !found {
-		// If mapping not found in API state it means that resource was changed outside Terraform
-		// and it must be deleted from Terraform state and recreated
-		tflog.Warn(ctx, "Unable to find issue field configuration scheme mapping in API state, deleting resource from state")
-		resp.State.RemoveResource(ctx)
+		removeNotFoundResource(ctx, resp, "issue field configuration scheme mapping")
 		return
 	}
 	tflog.Debug(ctx, "Retrieved issue field configuration scheme mapping from API state")
 
-	state.ID = types.StringValue(fmt.Sprintf("%s-%s-%s", state.FieldConfigurationSchemeID.ValueString(), state.FieldConfigurationID.ValueString(), state.IssueTypeID.ValueString()))
+	state.ID = types.StringValue(compositeID(state.FieldConfigurationSchemeID.ValueString(), state.FieldConfigurationID.ValueString(), state.IssueTypeID.ValueString()))
 
 	tflog.Debug(ctx, "Storing issue field configuration scheme mapping into the state", map[string]interface{}{
 		"readNewState": fmt.Sprintf("%+v", state),
@@ -216,6 +258,7 @@ func (r *jiraIssueFieldConfigurationSchemeMappingResource) Delete(ctx context.Co
 		return
 	}
 	tflog.Debug(ctx, "Loaded issue field configuration scheme mapping from state")
+	ctx = withSite(ctx, state.Site)
 
 	if state.IssueTypeID.ValueString() == "default" {
 		// It is not possible to delete a "default" mapping from API state
@@ -227,11 +270,7 @@ func (r *jiraIssueFieldConfigurationSchemeMappingResource) Delete(ctx context.Co
 	fieldConfigurationSchemeId, _ := strconv.Atoi(state.FieldConfigurationSchemeID.ValueString())
 	res, err := r.p.jira.Issue.Field.Configuration.Scheme.Unlink(ctx, fieldConfigurationSchemeId, []string{state.IssueTypeID.ValueString()})
 	if err != nil {
-		var resBody string
-		if res != nil {
-			resBody = res.Bytes.String()
-		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete issue field configuration scheme mapping, got error: %s\n%s", err, resBody))
+		resp.Diagnostics.Append(clientErrorDiagnostics("delete issue field configuration scheme mapping", res, err)...)
 		return
 	}
 	tflog.Debug(ctx, "Deleted issue field configuration scheme mapping from API state")
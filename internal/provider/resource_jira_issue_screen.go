@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"strconv"
 
-	jira "github.com/ctreminiom/go-atlassian/jira/v3"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -26,6 +25,8 @@ type (
 		ID          types.String `tfsdk:"id"`
 		Name        types.String `tfsdk:"name"`
 		Description types.String `tfsdk:"description"`
+
+		Site types.String `tfsdk:"site"`
 	}
 )
 
@@ -72,6 +73,7 @@ func (*jiraIssueScreenResource) Schema(_ context.Context, _ resource.SchemaReque
 					stringmodifiers.DefaultValue(""),
 				},
 			},
+			"site": resourceSiteAttribute(),
 		},
 	}
 }
@@ -82,20 +84,20 @@ func (r *jiraIssueScreenResource) Configure(ctx context.Context, req resource.Co
 		return
 	}
 
-	client, ok := req.ProviderData.(*jira.Client)
+	p, ok := req.ProviderData.(*atlassianProvider)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *jira.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *atlassianProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	r.p.jira = client
+	r.p = *p
 }
 
-func (*jiraIssueScreenResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+func (r *jiraIssueScreenResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	r.p.importState(ctx, req, resp, importAttribute{path.Root("id"), true})
 }
 
 func (r *jiraIssueScreenResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -106,17 +108,14 @@ func (r *jiraIssueScreenResource) Create(ctx context.Context, req resource.Creat
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	ctx = withSite(ctx, plan.Site)
 	tflog.Debug(ctx, "Loaded issue screen plan", map[string]interface{}{
 		"createPlan": fmt.Sprintf("%+v", plan),
 	})
 
-	newIssueScreen, res, err := r.p.jira.Screen.Create(ctx, plan.Name.ValueString(), plan.Description.ValueString())
+	newIssueScreen, res, err := r.p.jira.Screen.Create(ctx, r.p.prefixedName(plan.Name.ValueString()), r.p.suffixedDescription(plan.Description.ValueString()))
 	if err != nil {
-		var resBody string
-		if res != nil {
-			resBody = res.Bytes.String()
-		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create issue screen, got error: %s\n%s", err, resBody))
+		resp.Diagnostics.Append(clientErrorDiagnostics("create issue screen", res, err)...)
 		return
 	}
 	tflog.Debug(ctx, "Created issue screen")
@@ -137,6 +136,7 @@ func (r *jiraIssueScreenResource) Read(ctx context.Context, req resource.ReadReq
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	ctx = withSite(ctx, state.Site)
 	tflog.Debug(ctx, "Loaded issue screen from state", map[string]interface{}{
 		"readState": fmt.Sprintf("%+v", state),
 	})
@@ -145,17 +145,21 @@ func (r *jiraIssueScreenResource) Read(ctx context.Context, req resource.ReadReq
 
 	issueScreen, res, err := r.p.jira.Screen.Gets(ctx, []int{issueScreenId}, 0, 1)
 	if err != nil {
-		var resBody string
-		if res != nil {
-			resBody = res.Bytes.String()
+		if isNotFound(res) {
+			removeNotFoundResource(ctx, resp, "issue screen")
+			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get issue screen, got error: %s\n%s", err, resBody))
+		resp.Diagnostics.Append(clientErrorDiagnostics("get issue screen", res, err)...)
+		return
+	}
+	if len(issueScreen.Values) == 0 {
+		removeNotFoundResource(ctx, resp, "issue screen")
 		return
 	}
 	tflog.Debug(ctx, "Retrieved issue screen from API state")
 
-	state.Name = types.StringValue(issueScreen.Values[0].Name)
-	state.Description = types.StringValue(issueScreen.Values[0].Description)
+	state.Name = types.StringValue(r.p.unprefixedName(issueScreen.Values[0].Name))
+	state.Description = types.StringValue(r.p.unsuffixedDescription(issueScreen.Values[0].Description))
 
 	tflog.Debug(ctx, "Storing issue screen info into the state", map[string]interface{}{
 		"readNewState": fmt.Sprintf("%+v", state),
@@ -171,6 +175,7 @@ func (r *jiraIssueScreenResource) Update(ctx context.Context, req resource.Updat
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	ctx = withSite(ctx, plan.Site)
 	tflog.Debug(ctx, "Loaded issue screen plan", map[string]interface{}{
 		"updatePlan": fmt.Sprintf("%+v", plan),
 	})
@@ -185,13 +190,9 @@ func (r *jiraIssueScreenResource) Update(ctx context.Context, req resource.Updat
 	})
 
 	issueScreenId, _ := strconv.Atoi(state.ID.ValueString())
-	_, res, err := r.p.jira.Screen.Update(ctx, issueScreenId, plan.Name.ValueString(), plan.Description.ValueString())
+	_, res, err := r.p.jira.Screen.Update(ctx, issueScreenId, r.p.prefixedName(plan.Name.ValueString()), r.p.suffixedDescription(plan.Description.ValueString()))
 	if err != nil {
-		var resBody string
-		if res != nil {
-			resBody = res.Bytes.String()
-		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update issue screen, got error: %s\n%s", err, resBody))
+		resp.Diagnostics.Append(clientErrorDiagnostics("update issue screen", res, err)...)
 		return
 	}
 	tflog.Debug(ctx, "Updated issue screen in API state")
@@ -200,6 +201,7 @@ func (r *jiraIssueScreenResource) Update(ctx context.Context, req resource.Updat
 		ID:          types.StringValue(state.ID.ValueString()),
 		Name:        types.StringValue(plan.Name.ValueString()),
 		Description: types.StringValue(plan.Description.ValueString()),
+		Site:        plan.Site,
 	}
 
 	tflog.Debug(ctx, "Storing issue screen info into the state")
@@ -215,15 +217,12 @@ func (r *jiraIssueScreenResource) Delete(ctx context.Context, req resource.Delet
 		return
 	}
 	tflog.Debug(ctx, "Loaded issue screen from state")
+	ctx = withSite(ctx, state.Site)
 
 	issueScreenId, _ := strconv.Atoi(state.ID.ValueString())
 	res, err := r.p.jira.Screen.Delete(ctx, issueScreenId)
 	if err != nil {
-		var resBody string
-		if res != nil {
-			resBody = res.Bytes.String()
-		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete issue screen, got error: %s\n%s", err, resBody))
+		resp.Diagnostics.Append(clientErrorDiagnostics("delete issue screen", res, err)...)
 		return
 	}
 	tflog.Debug(ctx, "Removed issue screen from API state")
@@ -3,15 +3,17 @@ package atlassian
 import (
 	"context"
 	"fmt"
+	"net/http"
 
-	jira "github.com/ctreminiom/go-atlassian/jira/v3"
 	"github.com/ctreminiom/go-atlassian/pkg/infra/models"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -24,12 +26,27 @@ type (
 	}
 
 	jiraIssueTypeResourceModel struct {
-		ID             types.String `tfsdk:"id"`
-		Name           types.String `tfsdk:"name"`
-		Description    types.String `tfsdk:"description"`
-		Type           types.String `tfsdk:"type"`
-		HierarchyLevel types.Int64  `tfsdk:"hierarchy_level"`
-		AvatarId       types.Int64  `tfsdk:"avatar_id"`
+		ID             types.String             `tfsdk:"id"`
+		Name           types.String             `tfsdk:"name"`
+		Description    types.String             `tfsdk:"description"`
+		Type           types.String             `tfsdk:"type"`
+		HierarchyLevel types.Int64              `tfsdk:"hierarchy_level"`
+		AvatarId       types.Int64              `tfsdk:"avatar_id"`
+		Scope          *jiraIssueTypeScopeModel `tfsdk:"scope"`
+
+		Site types.String `tfsdk:"site"`
+	}
+
+	jiraIssueTypeScopeModel struct {
+		Type      types.String `tfsdk:"type"`
+		ProjectID types.String `tfsdk:"project_id"`
+	}
+
+	// jiraIssueTypeScopePayload adds the scope of the issue type to the create payload,
+	// which is not supported by models.IssueTypePayloadScheme.
+	jiraIssueTypeScopePayload struct {
+		*models.IssueTypePayloadScheme
+		Scope *models.IssueTypeScopeScheme `json:"scope,omitempty"`
 	}
 )
 
@@ -92,6 +109,35 @@ func (*jiraIssueTypeResource) Schema(_ context.Context, _ resource.SchemaRequest
 				Optional:            true,
 				Computed:            true,
 			},
+			"scope": schema.SingleNestedAttribute{
+				MarkdownDescription: "(Forces new) The scope of the issue type. Omit to create a global issue type for company-managed projects.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplace(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"type": schema.StringAttribute{
+						MarkdownDescription: "(Forces new) The scope of the issue type. `GLOBAL` for company-managed projects and `PROJECT` for team-managed projects.",
+						Required:            true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.RequiresReplace(),
+						},
+						Validators: []validator.String{
+							stringvalidator.OneOf("GLOBAL", "PROJECT"),
+						},
+					},
+					"project_id": schema.StringAttribute{
+						MarkdownDescription: "(Forces new) The ID of a team-managed project. Only use when `scope.type` is `PROJECT`.",
+						Optional:            true,
+						Computed:            true,
+						PlanModifiers: []planmodifier.String{
+							stringmodifiers.DefaultValue(""),
+							stringplanmodifier.RequiresReplace(),
+						},
+					},
+				},
+			},
+			"site": resourceSiteAttribute(),
 		},
 	}
 }
@@ -102,20 +148,20 @@ func (r *jiraIssueTypeResource) Configure(ctx context.Context, req resource.Conf
 		return
 	}
 
-	client, ok := req.ProviderData.(*jira.Client)
+	p, ok := req.ProviderData.(*atlassianProvider)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *jira.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *atlassianProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	r.p.jira = client
+	r.p = *p
 }
 
-func (*jiraIssueTypeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+func (r *jiraIssueTypeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	r.p.importState(ctx, req, resp, importAttribute{path.Root("id"), true})
 }
 
 func (r *jiraIssueTypeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -126,6 +172,7 @@ func (r *jiraIssueTypeResource) Create(ctx context.Context, req resource.CreateR
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	ctx = withSite(ctx, plan.Site)
 	tflog.Debug(ctx, "Loaded issue type plan", map[string]interface{}{
 		"createPlan": fmt.Sprintf("%+v", plan),
 	})
@@ -152,18 +199,36 @@ func (r *jiraIssueTypeResource) Create(ctx context.Context, req resource.CreateR
 		}
 	}
 
+	if plan.Scope != nil {
+		if plan.Scope.Type.ValueString() == "GLOBAL" && plan.Scope.ProjectID.ValueString() != "" {
+			resp.Diagnostics.AddAttributeError(path.Root("scope").AtName("project_id"),
+				"\"GLOBAL\" scope types must not have a value for \"scope.project_id\" attribute",
+				fmt.Sprintf("A value must not be provided if \"scope.type\" is: %s", plan.Scope.Type.ValueString()))
+			return
+		}
+		if plan.Scope.Type.ValueString() == "PROJECT" {
+			if plan.Scope.ProjectID.ValueString() == "" || plan.Scope.ProjectID.IsNull() {
+				resp.Diagnostics.AddAttributeError(path.Root("scope").AtName("project_id"),
+					"Failed to provide value for \"scope.project_id\" attribute",
+					fmt.Sprintf("A value must be provided if \"scope.type\" is: %s", plan.Scope.Type.ValueString()))
+				return
+			}
+
+			resp.Diagnostics.Append(validateTeamManagedProject(ctx, r.p.jira, path.Root("scope").AtName("project_id"), plan.Scope.ProjectID.ValueString())...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+	}
+
 	issueTypePayload := new(models.IssueTypePayloadScheme)
-	issueTypePayload.Name = plan.Name.ValueString()
-	issueTypePayload.Description = plan.Description.ValueString()
+	issueTypePayload.Name = r.p.prefixedName(plan.Name.ValueString())
+	issueTypePayload.Description = r.p.suffixedDescription(plan.Description.ValueString())
 	issueTypePayload.HierarchyLevel = int(plan.HierarchyLevel.ValueInt64())
 
-	returnedIssueType, res, err := r.p.jira.Issue.Type.Create(ctx, issueTypePayload)
+	returnedIssueType, res, err := r.createIssueType(ctx, issueTypePayload, plan.Scope)
 	if err != nil {
-		var resBody string
-		if res != nil {
-			resBody = res.Bytes.String()
-		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create issue type, got error: %s\n%s", err, resBody))
+		resp.Diagnostics.Append(clientErrorDiagnostics("create issue type", res, err)...)
 		return
 	}
 	tflog.Debug(ctx, "Created issue type")
@@ -172,17 +237,13 @@ func (r *jiraIssueTypeResource) Create(ctx context.Context, req resource.CreateR
 
 	if !plan.AvatarId.IsUnknown() {
 		issueTypePayload := new(models.IssueTypePayloadScheme)
-		issueTypePayload.Name = plan.Name.ValueString()
-		issueTypePayload.Description = plan.Description.ValueString()
+		issueTypePayload.Name = r.p.prefixedName(plan.Name.ValueString())
+		issueTypePayload.Description = r.p.suffixedDescription(plan.Description.ValueString())
 		issueTypePayload.AvatarID = int(plan.AvatarId.ValueInt64())
 
 		returnedIssueType, res, err := r.p.jira.Issue.Type.Update(ctx, returnedIssueType.ID, issueTypePayload)
 		if err != nil {
-			var resBody string
-			if res != nil {
-				resBody = res.Bytes.String()
-			}
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update issue type, got error: %s\n%s", err, resBody))
+			resp.Diagnostics.Append(clientErrorDiagnostics("update issue type", res, err)...)
 			return
 		}
 		plan.AvatarId = types.Int64Value(int64(returnedIssueType.AvatarID))
@@ -196,6 +257,36 @@ func (r *jiraIssueTypeResource) Create(ctx context.Context, req resource.CreateR
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
+// createIssueType creates a global issue type, or a project-scoped issue type if scope is of type `PROJECT`.
+func (r *jiraIssueTypeResource) createIssueType(ctx context.Context, payload *models.IssueTypePayloadScheme, scope *jiraIssueTypeScopeModel) (*models.IssueTypeScheme, *models.ResponseScheme, error) {
+	if scope == nil || scope.Type.ValueString() != "PROJECT" {
+		return r.p.jira.Issue.Type.Create(ctx, payload)
+	}
+
+	reader, err := r.p.jira.TransformStructToReader(&jiraIssueTypeScopePayload{
+		IssueTypePayloadScheme: payload,
+		Scope: &models.IssueTypeScopeScheme{
+			Type:    scope.Type.ValueString(),
+			Project: &models.ProjectScheme{ID: scope.ProjectID.ValueString()},
+		},
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	request, err := r.p.jira.NewRequest(ctx, http.MethodPost, "rest/api/3/issuetype", reader)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	issueType := new(models.IssueTypeScheme)
+	res, err := r.p.jira.Call(request, issueType)
+	if err != nil {
+		return nil, res, err
+	}
+	return issueType, res, nil
+}
+
 func (r *jiraIssueTypeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	tflog.Debug(ctx, "Reading issue type resource")
 
@@ -204,6 +295,7 @@ func (r *jiraIssueTypeResource) Read(ctx context.Context, req resource.ReadReque
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	ctx = withSite(ctx, state.Site)
 	tflog.Debug(ctx, "Loaded issue type from state", map[string]interface{}{
 		"readState": fmt.Sprintf("%+v", state),
 	})
@@ -212,13 +304,17 @@ func (r *jiraIssueTypeResource) Read(ctx context.Context, req resource.ReadReque
 
 	returnedIssueType, res, err := r.p.jira.Issue.Type.Get(ctx, issueTypeID)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read issue type, got error: %s\n%s", err.Error(), res.Bytes.String()))
+		if isNotFound(res) {
+			removeNotFoundResource(ctx, resp, "issue type")
+			return
+		}
+		resp.Diagnostics.Append(clientErrorDiagnostics("read issue type", res, err)...)
 		return
 	}
 	tflog.Debug(ctx, "Retrieved issue type from API state")
 
-	state.Name = types.StringValue(returnedIssueType.Name)
-	state.Description = types.StringValue(returnedIssueType.Description)
+	state.Name = types.StringValue(r.p.unprefixedName(returnedIssueType.Name))
+	state.Description = types.StringValue(r.p.unsuffixedDescription(returnedIssueType.Description))
 	if returnedIssueType.HierarchyLevel == 0 {
 		state.Type = types.StringValue("standard")
 	} else {
@@ -226,6 +322,20 @@ func (r *jiraIssueTypeResource) Read(ctx context.Context, req resource.ReadReque
 	}
 	state.HierarchyLevel = types.Int64Value(int64(returnedIssueType.HierarchyLevel))
 	state.AvatarId = types.Int64Value(int64(returnedIssueType.AvatarID))
+	if returnedIssueType.Scope != nil && returnedIssueType.Scope.Type == "PROJECT" {
+		state.Scope = &jiraIssueTypeScopeModel{
+			Type:      types.StringValue(returnedIssueType.Scope.Type),
+			ProjectID: types.StringValue(""),
+		}
+		if returnedIssueType.Scope.Project != nil {
+			state.Scope.ProjectID = types.StringValue(returnedIssueType.Scope.Project.ID)
+		}
+	} else if state.Scope != nil {
+		state.Scope = &jiraIssueTypeScopeModel{
+			Type:      types.StringValue("GLOBAL"),
+			ProjectID: types.StringValue(""),
+		}
+	}
 
 	tflog.Debug(ctx, "Storing issue type into the state", map[string]interface{}{
 		"readNewState": fmt.Sprintf("%+v", state),
@@ -241,6 +351,7 @@ func (r *jiraIssueTypeResource) Update(ctx context.Context, req resource.UpdateR
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	ctx = withSite(ctx, plan.Site)
 	tflog.Debug(ctx, "Loaded issue type plan", map[string]interface{}{
 		"updatePlan": fmt.Sprintf("%+v", plan),
 	})
@@ -257,24 +368,26 @@ func (r *jiraIssueTypeResource) Update(ctx context.Context, req resource.UpdateR
 	issueTypeID := state.ID.ValueString()
 
 	issueTypePayload := new(models.IssueTypePayloadScheme)
-	issueTypePayload.Name = plan.Name.ValueString()
-	issueTypePayload.Description = plan.Description.ValueString()
+	issueTypePayload.Name = r.p.prefixedName(plan.Name.ValueString())
+	issueTypePayload.Description = r.p.suffixedDescription(plan.Description.ValueString())
 	issueTypePayload.AvatarID = int(plan.AvatarId.ValueInt64())
 
 	returnedIssueType, res, err := r.p.jira.Issue.Type.Update(ctx, issueTypeID, issueTypePayload)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update issue type, got error: %s\n%s", err.Error(), res.Bytes.String()))
+		resp.Diagnostics.Append(clientErrorDiagnostics("update issue type", res, err)...)
 		return
 	}
 	tflog.Debug(ctx, "Updated issue type in API state")
 
 	var result = jiraIssueTypeResourceModel{
 		ID:             types.StringValue(returnedIssueType.ID),
-		Description:    types.StringValue(returnedIssueType.Description),
-		Name:           types.StringValue(returnedIssueType.Name),
+		Description:    types.StringValue(r.p.unsuffixedDescription(returnedIssueType.Description)),
+		Name:           types.StringValue(r.p.unprefixedName(returnedIssueType.Name)),
 		Type:           types.StringValue(state.Type.ValueString()),
 		AvatarId:       types.Int64Value(int64(returnedIssueType.AvatarID)),
 		HierarchyLevel: types.Int64Value(int64(returnedIssueType.HierarchyLevel)),
+		Scope:          plan.Scope,
+		Site:           plan.Site,
 	}
 
 	tflog.Debug(ctx, "Storing issue type into the state")
@@ -290,10 +403,11 @@ func (r *jiraIssueTypeResource) Delete(ctx context.Context, req resource.DeleteR
 		return
 	}
 	tflog.Debug(ctx, "Loaded issue type from state")
+	ctx = withSite(ctx, state.Site)
 
 	res, err := r.p.jira.Issue.Type.Delete(ctx, state.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete issue type, got error: %s\n%s", err, res.Bytes.String()))
+		resp.Diagnostics.Append(clientErrorDiagnostics("delete issue type", res, err)...)
 		return
 	}
 	tflog.Debug(ctx, "Deleted issue type from API state")
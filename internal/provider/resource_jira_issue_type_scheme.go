@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"strconv"
 
-	jira "github.com/ctreminiom/go-atlassian/jira/v3"
 	"github.com/ctreminiom/go-atlassian/pkg/infra/models"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -29,12 +28,19 @@ type (
 		Description        types.String `tfsdk:"description"`
 		DefaultIssueTypeId types.String `tfsdk:"default_issue_type_id"`
 		IssueTypeIds       types.List   `tfsdk:"issue_type_ids"`
+
+		DeletionProtection types.Bool `tfsdk:"deletion_protection"`
+		ForceDetach        types.Bool `tfsdk:"force_detach"`
+
+		Site types.String `tfsdk:"site"`
 	}
 )
 
 var (
-	_ resource.Resource                = (*jiraIssueTypeSchemeResource)(nil)
-	_ resource.ResourceWithImportState = (*jiraIssueTypeSchemeResource)(nil)
+	_ resource.Resource                   = (*jiraIssueTypeSchemeResource)(nil)
+	_ resource.ResourceWithImportState    = (*jiraIssueTypeSchemeResource)(nil)
+	_ resource.ResourceWithValidateConfig = (*jiraIssueTypeSchemeResource)(nil)
+	_ resource.ResourceWithModifyPlan     = (*jiraIssueTypeSchemeResource)(nil)
 )
 
 func NewJiraIssueTypeSchemeResource() resource.Resource {
@@ -73,7 +79,7 @@ func (*jiraIssueTypeSchemeResource) Schema(_ context.Context, _ resource.SchemaR
 				},
 			},
 			"default_issue_type_id": schema.StringAttribute{
-				MarkdownDescription: "The ID of the default issue type of the issue type scheme. This ID must be included in issue_type_ids.",
+				MarkdownDescription: "The ID of the default issue type of the issue type scheme. This ID must be included in issue_type_ids and cannot be a subtask issue type.",
 				Optional:            true,
 				Computed:            true,
 				PlanModifiers: []planmodifier.String{
@@ -81,10 +87,13 @@ func (*jiraIssueTypeSchemeResource) Schema(_ context.Context, _ resource.SchemaR
 				},
 			},
 			"issue_type_ids": schema.ListAttribute{
-				MarkdownDescription: "The list of issue types IDs of the issue type scheme. At least one standard issue type ID is required.",
+				MarkdownDescription: "The list of issue types IDs of the issue type scheme. The IDs must be unique and at least one standard issue type ID is required.",
 				Required:            true,
 				ElementType:         types.StringType,
 			},
+			"deletion_protection": deletionProtectionAttribute("issue type scheme"),
+			"force_detach":        forceDetachAttribute("issue type scheme"),
+			"site":                resourceSiteAttribute(),
 		},
 	}
 }
@@ -95,20 +104,147 @@ func (r *jiraIssueTypeSchemeResource) Configure(ctx context.Context, req resourc
 		return
 	}
 
-	client, ok := req.ProviderData.(*jira.Client)
+	p, ok := req.ProviderData.(*atlassianProvider)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *jira.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *atlassianProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	r.p.jira = client
+	r.p = *p
+}
+
+func (r *jiraIssueTypeSchemeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	r.p.importState(ctx, req, resp, importAttribute{path.Root("id"), true})
+}
+
+func (*jiraIssueTypeSchemeResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config jiraIssueTypeSchemeResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.IssueTypeIds.IsNull() || config.IssueTypeIds.IsUnknown() {
+		return
+	}
+
+	// Validate that issue_type_ids are unique
+	seen := make(map[string]bool)
+	for _, v := range config.IssueTypeIds.Elements() {
+		id, ok := v.(types.String)
+		if !ok || id.IsNull() || id.IsUnknown() {
+			continue
+		}
+		if seen[id.ValueString()] {
+			resp.Diagnostics.AddAttributeError(path.Root("issue_type_ids"), "Duplicate Issue Type ID",
+				fmt.Sprintf("Issue type ID %q is included more than once in issue_type_ids.", id.ValueString()))
+			continue
+		}
+		seen[id.ValueString()] = true
+	}
+
+	// Validate that default_issue_type_id is included in issue_type_ids
+	if config.DefaultIssueTypeId.IsNull() || config.DefaultIssueTypeId.IsUnknown() || config.DefaultIssueTypeId.ValueString() == "" {
+		return
+	}
+	for _, v := range config.IssueTypeIds.Elements() {
+		if v.IsUnknown() {
+			// The default issue type might be one of the unknown IDs.
+			return
+		}
+	}
+	if !seen[config.DefaultIssueTypeId.ValueString()] {
+		resp.Diagnostics.AddAttributeError(path.Root("default_issue_type_id"), "Invalid Default Issue Type ID",
+			"Value of default_issue_type_id must be included in issue_type_ids.")
+	}
 }
 
-func (*jiraIssueTypeSchemeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+func (r *jiraIssueTypeSchemeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Replacing the issue type scheme deletes it first
+	resp.Diagnostics.Append(replacePlanDiagnostics(ctx, "issue type scheme", req, resp)...)
+
+	// Nothing to validate when the provider has not been configured yet
+	if r.p.jira == nil {
+		return
+	}
+
+	if req.Plan.Raw.IsNull() {
+		if req.State.Raw.IsNull() {
+			return
+		}
+		var state jiraIssueTypeSchemeResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		ctx = withSite(ctx, state.Site)
+		resp.Diagnostics.Append(destroyPlanDiagnostics(ctx, "issue type scheme", state.Name.ValueString(), state.DeletionProtection.ValueBool(), state.ForceDetach.ValueBool(), jiraIssueTypeSchemeType.usage(r.p.jira, state.ID.ValueString()))...)
+		return
+	}
+
+	var plan jiraIssueTypeSchemeResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.IssueTypeIds.IsUnknown() || plan.Site.IsUnknown() {
+		return
+	}
+	ctx = withSite(ctx, plan.Site)
+	var issueTypeIds []string
+	for _, v := range plan.IssueTypeIds.Elements() {
+		if v.IsUnknown() {
+			// IDs of issue types created in the same apply can only be validated by the API.
+			return
+		}
+	}
+	resp.Diagnostics.Append(plan.IssueTypeIds.ElementsAs(ctx, &issueTypeIds, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	issueTypes, res, err := r.p.jira.Issue.Type.Gets(ctx)
+	if err != nil {
+		resp.Diagnostics.Append(clientErrorDiagnostics("get issue types", res, err)...)
+		return
+	}
+	hierarchyLevels := make(map[string]int, len(issueTypes))
+	for _, issueType := range issueTypes {
+		hierarchyLevels[issueType.ID] = issueType.HierarchyLevel
+	}
+
+	// Validate that issue_type_ids exist
+	var hasStandardIssueType bool
+	for _, id := range issueTypeIds {
+		level, ok := hierarchyLevels[id]
+		if !ok {
+			resp.Diagnostics.AddAttributeError(path.Root("issue_type_ids"), "Unknown Issue Type ID",
+				fmt.Sprintf("Issue type with ID %q does not exist.", id))
+			continue
+		}
+		if level == 0 {
+			hasStandardIssueType = true
+		}
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Validate that the hierarchy levels of issue_type_ids are valid together
+	if !hasStandardIssueType {
+		resp.Diagnostics.AddAttributeError(path.Root("issue_type_ids"), "Invalid Issue Type Hierarchy",
+			"At least one standard issue type (hierarchy level `0`) must be included in issue_type_ids. "+
+				"Subtask (`-1`) and epic (`1`) issue types cannot be used on their own.")
+	}
+	defaultIssueTypeId := plan.DefaultIssueTypeId.ValueString()
+	if level, ok := hierarchyLevels[defaultIssueTypeId]; ok && level < 0 {
+		resp.Diagnostics.AddAttributeError(path.Root("default_issue_type_id"), "Invalid Default Issue Type ID",
+			fmt.Sprintf("Issue type with ID %q is a subtask issue type and cannot be the default issue type.", defaultIssueTypeId))
+	}
 }
 
 func (r *jiraIssueTypeSchemeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -119,26 +255,14 @@ func (r *jiraIssueTypeSchemeResource) Create(ctx context.Context, req resource.C
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	ctx = withSite(ctx, plan.Site)
 	tflog.Debug(ctx, "Loaded issue type scheme plan", map[string]interface{}{
 		"createPlan": fmt.Sprintf("%+v", plan),
 	})
 
-	if plan.DefaultIssueTypeId.ValueString() != "" {
-		flag := false
-		for _, v := range plan.IssueTypeIds.Elements() {
-			if v == plan.DefaultIssueTypeId {
-				flag = true
-			}
-		}
-		if !flag {
-			resp.Diagnostics.AddError("User Error", "Value of default_issue_type_id must be included in issue_type_ids.")
-			return
-		}
-	}
-
 	issueTypeSchemePayload := new(models.IssueTypeSchemePayloadScheme)
-	issueTypeSchemePayload.Name = plan.Name.ValueString()
-	issueTypeSchemePayload.Description = plan.Description.ValueString()
+	issueTypeSchemePayload.Name = r.p.prefixedName(plan.Name.ValueString())
+	issueTypeSchemePayload.Description = r.p.suffixedDescription(plan.Description.ValueString())
 	issueTypeSchemePayload.DefaultIssueTypeID = plan.DefaultIssueTypeId.ValueString()
 	resp.Diagnostics.Append(plan.IssueTypeIds.ElementsAs(ctx, &issueTypeSchemePayload.IssueTypeIds, false)...)
 	if resp.Diagnostics.HasError() {
@@ -147,11 +271,7 @@ func (r *jiraIssueTypeSchemeResource) Create(ctx context.Context, req resource.C
 
 	returnedIssueTypeScheme, res, err := r.p.jira.Issue.Type.Scheme.Create(ctx, issueTypeSchemePayload)
 	if err != nil {
-		var resBody string
-		if res != nil {
-			resBody = res.Bytes.String()
-		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create issue type scheme, got error: %s\n%s", err, resBody))
+		resp.Diagnostics.Append(clientErrorDiagnostics("create issue type scheme", res, err)...)
 		return
 	}
 	tflog.Debug(ctx, "Created issue type scheme")
@@ -172,6 +292,7 @@ func (r *jiraIssueTypeSchemeResource) Read(ctx context.Context, req resource.Rea
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	ctx = withSite(ctx, state.Site)
 	tflog.Debug(ctx, "Loaded issue type scheme from state", map[string]interface{}{
 		"readState": fmt.Sprintf("%+v", state),
 	})
@@ -180,21 +301,25 @@ func (r *jiraIssueTypeSchemeResource) Read(ctx context.Context, req resource.Rea
 
 	issueTypeScheme, res, err := r.p.jira.Issue.Type.Scheme.Gets(ctx, []int{issueTypeSchemeID}, 0, 1)
 	if err != nil {
-		var resBody string
-		if res != nil {
-			resBody = res.Bytes.String()
+		if isNotFound(res) {
+			removeNotFoundResource(ctx, resp, "issue type scheme")
+			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read issue type scheme, got error: %s\n%s", err, resBody))
+		resp.Diagnostics.Append(clientErrorDiagnostics("read issue type scheme", res, err)...)
+		return
+	}
+	if len(issueTypeScheme.Values) == 0 {
+		removeNotFoundResource(ctx, resp, "issue type scheme")
 		return
 	}
 
 	issueTypeSchemeItems, res, err := r.p.jira.Issue.Type.Scheme.Items(ctx, []int{issueTypeSchemeID}, 0, 50)
 	if err != nil {
-		var resBody string
-		if res != nil {
-			resBody = res.Bytes.String()
+		if isNotFound(res) {
+			removeNotFoundResource(ctx, resp, "issue type scheme")
+			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get issue type scheme items, got error: %s\n%s", err, resBody))
+		resp.Diagnostics.Append(clientErrorDiagnostics("get issue type scheme items", res, err)...)
 		return
 	}
 	ids := types.ListNull(types.StringType)
@@ -204,8 +329,8 @@ func (r *jiraIssueTypeSchemeResource) Read(ctx context.Context, req resource.Rea
 	}
 	tflog.Debug(ctx, "Retrieved issue type scheme from API state")
 
-	state.Name = types.StringValue(issueTypeScheme.Values[0].Name)
-	state.Description = types.StringValue(issueTypeScheme.Values[0].Description)
+	state.Name = types.StringValue(r.p.unprefixedName(issueTypeScheme.Values[0].Name))
+	state.Description = types.StringValue(r.p.unsuffixedDescription(issueTypeScheme.Values[0].Description))
 	state.DefaultIssueTypeId = types.StringValue(issueTypeScheme.Values[0].DefaultIssueTypeID)
 	state.IssueTypeIds = ids
 
@@ -223,6 +348,7 @@ func (r *jiraIssueTypeSchemeResource) Update(ctx context.Context, req resource.U
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	ctx = withSite(ctx, plan.Site)
 	tflog.Debug(ctx, "Loaded issue type scheme plan", map[string]interface{}{
 		"updatePlan": fmt.Sprintf("%+v", plan),
 	})
@@ -239,33 +365,15 @@ func (r *jiraIssueTypeSchemeResource) Update(ctx context.Context, req resource.U
 	issueTypeSchemeID, _ := strconv.Atoi(state.ID.ValueString())
 
 	issueTypeSchemePayload := new(models.IssueTypeSchemePayloadScheme)
-	issueTypeSchemePayload.Name = plan.Name.ValueString()
-	issueTypeSchemePayload.Description = plan.Description.ValueString()
+	issueTypeSchemePayload.Name = r.p.prefixedName(plan.Name.ValueString())
+	issueTypeSchemePayload.Description = r.p.suffixedDescription(plan.Description.ValueString())
 
 	res, err := r.p.jira.Issue.Type.Scheme.Update(ctx, issueTypeSchemeID, issueTypeSchemePayload)
 	if err != nil {
-		var resBody string
-		if res != nil {
-			resBody = res.Bytes.String()
-		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update issue type scheme, got error: %s\n%s", err, resBody))
+		resp.Diagnostics.Append(clientErrorDiagnostics("update issue type scheme", res, err)...)
 		return
 	}
 
-	// Validate that default_issue_type_id is included in issue_type_ids
-	if plan.DefaultIssueTypeId.ValueString() != "" {
-		flag := false
-		for _, v := range plan.IssueTypeIds.Elements() {
-			if v == plan.DefaultIssueTypeId {
-				flag = true
-			}
-		}
-		if !flag {
-			resp.Diagnostics.AddError("User Error", "Value of default_issue_type_id must be included in issue_type_ids.")
-			return
-		}
-	}
-
 	// Validate that new issue type(s) need to be added to issue type scheme
 	var ids []int
 	var exists bool
@@ -286,11 +394,7 @@ func (r *jiraIssueTypeSchemeResource) Update(ctx context.Context, req resource.U
 	if len(ids) != 0 {
 		res, err = r.p.jira.Issue.Type.Scheme.Append(ctx, issueTypeSchemeID, ids)
 		if err != nil {
-			var resBody string
-			if res != nil {
-				resBody = res.Bytes.String()
-			}
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to add issue types to issue type scheme, got error: %s\n%s", err, resBody))
+			resp.Diagnostics.Append(clientErrorDiagnostics("add issue types to issue type scheme", res, err)...)
 			return
 		}
 	}
@@ -302,6 +406,9 @@ func (r *jiraIssueTypeSchemeResource) Update(ctx context.Context, req resource.U
 		Description:        types.StringValue(plan.Description.ValueString()),
 		DefaultIssueTypeId: types.StringValue(plan.DefaultIssueTypeId.ValueString()),
 		IssueTypeIds:       plan.IssueTypeIds,
+		DeletionProtection: plan.DeletionProtection,
+		ForceDetach:        plan.ForceDetach,
+		Site:               plan.Site,
 	}
 
 	tflog.Debug(ctx, "Storing issue type scheme into the state")
@@ -317,16 +424,23 @@ func (r *jiraIssueTypeSchemeResource) Delete(ctx context.Context, req resource.D
 		return
 	}
 	tflog.Debug(ctx, "Loaded issue type scheme from state")
+	ctx = withSite(ctx, state.Site)
+
+	if state.DeletionProtection.ValueBool() {
+		resp.Diagnostics.Append(deletionProtectionDiagnostics("issue type scheme", state.Name.ValueString())...)
+		return
+	}
+
+	resp.Diagnostics.Append(jiraIssueTypeSchemeType.detachProjects(ctx, r.p.jira, state.ID.ValueString(), state.Name.ValueString(), state.ForceDetach.ValueBool())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	issueTypeSchemeID, _ := strconv.Atoi(state.ID.ValueString())
 
 	res, err := r.p.jira.Issue.Type.Scheme.Delete(ctx, issueTypeSchemeID)
 	if err != nil {
-		var resBody string
-		if res != nil {
-			resBody = res.Bytes.String()
-		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete issue type scheme, got error: %s\n%s", err, resBody))
+		resp.Diagnostics.Append(clientErrorDiagnostics("delete issue type scheme", res, err)...)
 		return
 	}
 	tflog.Debug(ctx, "Deleted issue type scheme from API state")
@@ -0,0 +1,207 @@
+package atlassian
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+type (
+	jiraIssueTypeSchemeAssociationResource struct {
+		p atlassianProvider
+	}
+
+	jiraIssueTypeSchemeAssociationResourceModel struct {
+		ID                types.String `tfsdk:"id"`
+		ProjectID         types.String `tfsdk:"project_id"`
+		IssueTypeSchemeID types.String `tfsdk:"issue_type_scheme_id"`
+
+		Site types.String `tfsdk:"site"`
+	}
+)
+
+var (
+	_ resource.Resource                = (*jiraIssueTypeSchemeAssociationResource)(nil)
+	_ resource.ResourceWithImportState = (*jiraIssueTypeSchemeAssociationResource)(nil)
+)
+
+func NewJiraIssueTypeSchemeAssociationResource() resource.Resource {
+	return &jiraIssueTypeSchemeAssociationResource{}
+}
+
+func (*jiraIssueTypeSchemeAssociationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_jira_issue_type_scheme_association"
+}
+
+func (*jiraIssueTypeSchemeAssociationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version:             1,
+		MarkdownDescription: "Jira Issue Type Scheme Association Resource",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the issue type scheme association. " +
+					"It is computed using `project_id` and `issue_type_scheme_id` separated by a comma (`,`).",
+				Computed: true,
+			},
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "(Forces new resource) The ID of the project.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"issue_type_scheme_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the issue type scheme to assign to the project. " +
+					"On destroy, the default issue type scheme is assigned to the project.",
+				Required: true,
+			},
+			"site": resourceSiteAttribute(),
+		},
+	}
+}
+
+func (r *jiraIssueTypeSchemeAssociationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	p, ok := req.ProviderData.(*atlassianProvider)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *atlassianProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.p = *p
+}
+
+func (r *jiraIssueTypeSchemeAssociationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	r.p.importState(ctx, req, resp,
+		importAttribute{path.Root("project_id"), true},
+		importAttribute{path.Root("issue_type_scheme_id"), true},
+	)
+}
+
+func (r *jiraIssueTypeSchemeAssociationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Creating issue type scheme association resource")
+
+	var plan jiraIssueTypeSchemeAssociationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx = withSite(ctx, plan.Site)
+	tflog.Debug(ctx, "Loaded issue type scheme association plan", map[string]interface{}{
+		"createPlan": fmt.Sprintf("%+v", plan),
+	})
+
+	res, err := r.p.jira.Issue.Type.Scheme.Assign(ctx, plan.IssueTypeSchemeID.ValueString(), plan.ProjectID.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(clientErrorDiagnostics("assign issue type scheme to project", res, err)...)
+		return
+	}
+	tflog.Debug(ctx, "Created issue type scheme association")
+
+	plan.ID = types.StringValue(compositeID(plan.ProjectID.ValueString(), plan.IssueTypeSchemeID.ValueString()))
+
+	tflog.Debug(ctx, "Storing issue type scheme association into the state", map[string]interface{}{
+		"createNewState": fmt.Sprintf("%+v", plan),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *jiraIssueTypeSchemeAssociationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Reading issue type scheme association resource")
+
+	var state jiraIssueTypeSchemeAssociationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx = withSite(ctx, state.Site)
+	tflog.Debug(ctx, "Loaded issue type scheme association from state", map[string]interface{}{
+		"readState": fmt.Sprintf("%+v", state),
+	})
+
+	projectId, _ := strconv.Atoi(state.ProjectID.ValueString())
+	projectIssueTypeSchemes, res, err := r.p.jira.Issue.Type.Scheme.Projects(ctx, []int{projectId}, 0, 1)
+	if err != nil {
+		if isNotFound(res) {
+			removeNotFoundResource(ctx, resp, "issue type scheme association")
+			return
+		}
+		resp.Diagnostics.Append(clientErrorDiagnostics("get project issue type scheme", res, err)...)
+		return
+	}
+	tflog.Debug(ctx, "Retrieved issue type scheme association from API state")
+
+	for _, v := range projectIssueTypeSchemes.Values {
+		if v.IssueTypeScheme != nil {
+			state.IssueTypeSchemeID = types.StringValue(v.IssueTypeScheme.ID)
+		}
+	}
+	state.ID = types.StringValue(compositeID(state.ProjectID.ValueString(), state.IssueTypeSchemeID.ValueString()))
+
+	tflog.Debug(ctx, "Storing issue type scheme association into the state", map[string]interface{}{
+		"readNewState": fmt.Sprintf("%+v", state),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *jiraIssueTypeSchemeAssociationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Updating issue type scheme association resource")
+
+	var plan jiraIssueTypeSchemeAssociationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx = withSite(ctx, plan.Site)
+	tflog.Debug(ctx, "Loaded issue type scheme association plan", map[string]interface{}{
+		"updatePlan": fmt.Sprintf("%+v", plan),
+	})
+
+	res, err := r.p.jira.Issue.Type.Scheme.Assign(ctx, plan.IssueTypeSchemeID.ValueString(), plan.ProjectID.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(clientErrorDiagnostics("assign issue type scheme to project", res, err)...)
+		return
+	}
+	tflog.Debug(ctx, "Updated issue type scheme association in API state")
+
+	plan.ID = types.StringValue(compositeID(plan.ProjectID.ValueString(), plan.IssueTypeSchemeID.ValueString()))
+
+	tflog.Debug(ctx, "Storing issue type scheme association into the state")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *jiraIssueTypeSchemeAssociationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Deleting issue type scheme association resource")
+
+	var state jiraIssueTypeSchemeAssociationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded issue type scheme association from state")
+	ctx = withSite(ctx, state.Site)
+
+	res, err := r.p.jira.Issue.Type.Scheme.Assign(ctx, jiraDefaultIssueTypeSchemeID, state.ProjectID.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(clientErrorDiagnostics("assign default issue type scheme to project", res, err)...)
+		return
+	}
+	tflog.Debug(ctx, "Deleted issue type scheme association from API state")
+
+	// If a Resource type Delete method is completed without error, the framework will automatically remove the resource.
+}
@@ -2,6 +2,7 @@ package atlassian
 
 import (
 	"fmt"
+	"regexp"
 	"strings"
 	"testing"
 
@@ -81,6 +82,29 @@ func TestAccJiraIssueTypeScheme_IssueTypeIds(t *testing.T) {
 	})
 }
 
+func TestAccJiraIssueTypeScheme_Validation(t *testing.T) {
+	randomName := acctest.RandomWithPrefix("tf-test-issue-type-scheme")
+	resourceName := "atlassian_jira_issue_type_scheme.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccJiraIssueTypeSchemeConfig_validation(resourceName, randomName, `"10000"`, `["10001"]`),
+				ExpectError: regexp.MustCompile(`must be included in issue_type_ids`),
+			},
+			{
+				Config:      testAccJiraIssueTypeSchemeConfig_validation(resourceName, randomName, `""`, `["10001", "10001"]`),
+				ExpectError: regexp.MustCompile(`Duplicate Issue Type ID`),
+			},
+			{
+				Config:      testAccJiraIssueTypeSchemeConfig_validation(resourceName, randomName, `""`, `["999999"]`),
+				ExpectError: regexp.MustCompile(`Unknown Issue Type ID`),
+			},
+		},
+	})
+}
+
 func testAccJiraIssueTypeSchemeConfig_basic(resourceName, name string) string {
 	splits := strings.Split(resourceName, ".")
 	return fmt.Sprintf(`
@@ -128,3 +152,14 @@ func testAccJiraIssueTypeSchemeConfig_description(resourceName, name, descriptio
 // 	}
 // 	`, splits[0], splits[1], name)
 // }
+
+func testAccJiraIssueTypeSchemeConfig_validation(resourceName, name, defaultIssueTypeId, issueTypeIds string) string {
+	splits := strings.Split(resourceName, ".")
+	return fmt.Sprintf(`
+	resource %[1]q %[2]q {
+		name = %[3]q
+		default_issue_type_id = %[4]s
+		issue_type_ids = %[5]s
+	}
+	`, splits[0], splits[1], name, defaultIssueTypeId, issueTypeIds)
+}
@@ -5,9 +5,9 @@ import (
 	"fmt"
 	"strconv"
 
-	jira "github.com/ctreminiom/go-atlassian/jira/v3"
 	"github.com/ctreminiom/go-atlassian/pkg/infra/models"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -28,6 +28,11 @@ type (
 		Name              types.String                       `tfsdk:"name"`
 		Description       types.String                       `tfsdk:"description"`
 		IssueTypeMappings []jiraIssueTypeScreenSchemeMapping `tfsdk:"issue_type_mappings"`
+
+		DeletionProtection types.Bool `tfsdk:"deletion_protection"`
+		ForceDetach        types.Bool `tfsdk:"force_detach"`
+
+		Site types.String `tfsdk:"site"`
 	}
 
 	jiraIssueTypeScreenSchemeMapping struct {
@@ -39,6 +44,7 @@ type (
 var (
 	_ resource.Resource                = (*jiraIssueTypeScreenSchemeResource)(nil)
 	_ resource.ResourceWithImportState = (*jiraIssueTypeScreenSchemeResource)(nil)
+	_ resource.ResourceWithModifyPlan  = (*jiraIssueTypeScreenSchemeResource)(nil)
 )
 
 func NewJiraIssueTypeScreenSchemeResource() resource.Resource {
@@ -99,6 +105,9 @@ func (*jiraIssueTypeScreenSchemeResource) Schema(_ context.Context, _ resource.S
 					},
 				},
 			},
+			"deletion_protection": deletionProtectionAttribute("issue type screen scheme"),
+			"force_detach":        forceDetachAttribute("issue type screen scheme"),
+			"site":                resourceSiteAttribute(),
 		},
 	}
 }
@@ -109,20 +118,39 @@ func (r *jiraIssueTypeScreenSchemeResource) Configure(ctx context.Context, req r
 		return
 	}
 
-	client, ok := req.ProviderData.(*jira.Client)
+	p, ok := req.ProviderData.(*atlassianProvider)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *jira.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *atlassianProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	r.p.jira = client
+	r.p = *p
 }
 
-func (*jiraIssueTypeScreenSchemeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+func (r *jiraIssueTypeScreenSchemeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	r.p.importState(ctx, req, resp, importAttribute{path.Root("id"), true})
+}
+
+func (r *jiraIssueTypeScreenSchemeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Replacing the issue type screen scheme deletes it first
+	resp.Diagnostics.Append(replacePlanDiagnostics(ctx, "issue type screen scheme", req, resp)...)
+
+	// Otherwise, only the plans to destroy the issue type screen scheme are checked
+	if !req.Plan.Raw.IsNull() || req.State.Raw.IsNull() || r.p.jira == nil {
+		return
+	}
+
+	var state jiraIssueTypeScreenSchemeResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx = withSite(ctx, state.Site)
+
+	resp.Diagnostics.Append(destroyPlanDiagnostics(ctx, "issue type screen scheme", state.Name.ValueString(), state.DeletionProtection.ValueBool(), state.ForceDetach.ValueBool(), jiraIssueTypeScreenSchemeType.usage(r.p.jira, state.ID.ValueString()))...)
 }
 
 func (r *jiraIssueTypeScreenSchemeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -133,6 +161,7 @@ func (r *jiraIssueTypeScreenSchemeResource) Create(ctx context.Context, req reso
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	ctx = withSite(ctx, plan.Site)
 	tflog.Debug(ctx, "Loaded issue type screen scheme plan", map[string]interface{}{
 		"createPlan": fmt.Sprintf("%+v", plan),
 	})
@@ -146,18 +175,14 @@ func (r *jiraIssueTypeScreenSchemeResource) Create(ctx context.Context, req reso
 	}
 
 	createRequestPayload := models.IssueTypeScreenSchemePayloadScheme{
-		Name:              plan.Name.ValueString(),
-		Description:       plan.Description.ValueString(),
+		Name:              r.p.prefixedName(plan.Name.ValueString()),
+		Description:       r.p.suffixedDescription(plan.Description.ValueString()),
 		IssueTypeMappings: issueTypeMappings,
 	}
 
 	newIssueTypeScreenScheme, res, err := r.p.jira.Issue.Type.ScreenScheme.Create(ctx, &createRequestPayload)
 	if err != nil {
-		var resBody string
-		if res != nil {
-			resBody = res.Bytes.String()
-		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create issue type screen scheme, got error: %s\n%s", err, resBody))
+		resp.Diagnostics.Append(clientErrorDiagnostics("create issue type screen scheme", res, err)...)
 		return
 	}
 	tflog.Debug(ctx, "Created issue type screen scheme")
@@ -178,6 +203,7 @@ func (r *jiraIssueTypeScreenSchemeResource) Read(ctx context.Context, req resour
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	ctx = withSite(ctx, state.Site)
 	tflog.Debug(ctx, "Loaded issue type screen scheme from state", map[string]interface{}{
 		"readState": fmt.Sprintf("%+v", state),
 	})
@@ -188,27 +214,31 @@ func (r *jiraIssueTypeScreenSchemeResource) Read(ctx context.Context, req resour
 	}
 	issueTypeScreenSchemeDetails, res, err := r.p.jira.Issue.Type.ScreenScheme.Gets(ctx, options, 0, 1)
 	if err != nil {
-		var resBody string
-		if res != nil {
-			resBody = res.Bytes.String()
+		if isNotFound(res) {
+			removeNotFoundResource(ctx, resp, "issue type screen scheme")
+			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get issue type screen scheme, got error: %s\n%s", err, resBody))
+		resp.Diagnostics.Append(clientErrorDiagnostics("get issue type screen scheme", res, err)...)
+		return
+	}
+	if len(issueTypeScreenSchemeDetails.Values) == 0 {
+		removeNotFoundResource(ctx, resp, "issue type screen scheme")
 		return
 	}
 
 	issueTypeScreenSchemeMappings, res, err := r.p.jira.Issue.Type.ScreenScheme.Mapping(ctx, []int{issueTypeScreenSchemeId}, 0, 50)
 	if err != nil {
-		var resBody string
-		if res != nil {
-			resBody = res.Bytes.String()
+		if isNotFound(res) {
+			removeNotFoundResource(ctx, resp, "issue type screen scheme")
+			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get issue type screen scheme mappings, got error: %s\n%s", err, resBody))
+		resp.Diagnostics.Append(clientErrorDiagnostics("get issue type screen scheme mappings", res, err)...)
 		return
 	}
 	tflog.Debug(ctx, "Retrieved issue type screen scheme from API state")
 
-	state.Name = types.StringValue(issueTypeScreenSchemeDetails.Values[0].Name)
-	state.Description = types.StringValue(issueTypeScreenSchemeDetails.Values[0].Description)
+	state.Name = types.StringValue(r.p.unprefixedName(issueTypeScreenSchemeDetails.Values[0].Name))
+	state.Description = types.StringValue(r.p.unsuffixedDescription(issueTypeScreenSchemeDetails.Values[0].Description))
 	var mappings []jiraIssueTypeScreenSchemeMapping
 	for _, v := range issueTypeScreenSchemeMappings.Values {
 		mappings = append(mappings, jiraIssueTypeScreenSchemeMapping{
@@ -232,6 +262,7 @@ func (r *jiraIssueTypeScreenSchemeResource) Update(ctx context.Context, req reso
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	ctx = withSite(ctx, plan.Site)
 	tflog.Debug(ctx, "Loaded issue type screen scheme plan", map[string]interface{}{
 		"updatePlan": fmt.Sprintf("%+v", plan),
 	})
@@ -245,27 +276,23 @@ func (r *jiraIssueTypeScreenSchemeResource) Update(ctx context.Context, req reso
 		"updateState": fmt.Sprintf("%+v", state),
 	})
 
-	err := r.updateNameAndDescription(ctx, &plan, &state)
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", err.Error())
+	resp.Diagnostics.Append(r.updateNameAndDescription(ctx, &plan, &state)...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	err = r.updateDefaultMapping(ctx, &plan, &state)
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", err.Error())
+	resp.Diagnostics.Append(r.updateDefaultMapping(ctx, &plan, &state)...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	err = r.addMappings(ctx, &plan, &state)
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", err.Error())
+	resp.Diagnostics.Append(r.addMappings(ctx, &plan, &state)...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	err = r.removeMappings(ctx, &plan, &state)
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", err.Error())
+	resp.Diagnostics.Append(r.removeMappings(ctx, &plan, &state)...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
@@ -286,14 +313,21 @@ func (r *jiraIssueTypeScreenSchemeResource) Delete(ctx context.Context, req reso
 		return
 	}
 	tflog.Debug(ctx, "Loaded issue type screen scheme from state")
+	ctx = withSite(ctx, state.Site)
+
+	if state.DeletionProtection.ValueBool() {
+		resp.Diagnostics.Append(deletionProtectionDiagnostics("issue type screen scheme", state.Name.ValueString())...)
+		return
+	}
+
+	resp.Diagnostics.Append(jiraIssueTypeScreenSchemeType.detachProjects(ctx, r.p.jira, state.ID.ValueString(), state.Name.ValueString(), state.ForceDetach.ValueBool())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	res, err := r.p.jira.Issue.Type.ScreenScheme.Delete(ctx, state.ID.ValueString())
 	if err != nil {
-		var resBody string
-		if res != nil {
-			resBody = res.Bytes.String()
-		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete issue type screen scheme, got error: %s\n%s", err, resBody))
+		resp.Diagnostics.Append(clientErrorDiagnostics("delete issue type screen scheme", res, err)...)
 		return
 	}
 	tflog.Debug(ctx, "Deleted issue type screen scheme from API state")
@@ -301,15 +335,11 @@ func (r *jiraIssueTypeScreenSchemeResource) Delete(ctx context.Context, req reso
 	// If a Resource type Delete method is completed without error, the framework will automatically remove the resource.
 }
 
-func (r *jiraIssueTypeScreenSchemeResource) updateNameAndDescription(ctx context.Context, p, s *jiraIssueTypeScreenSchemeResourceModel) error {
+func (r *jiraIssueTypeScreenSchemeResource) updateNameAndDescription(ctx context.Context, p, s *jiraIssueTypeScreenSchemeResourceModel) diag.Diagnostics {
 	if p.Name.ValueString() != s.Name.ValueString() || p.Description.ValueString() != s.Description.ValueString() {
-		res, err := r.p.jira.Issue.Type.ScreenScheme.Update(ctx, s.ID.ValueString(), p.Name.ValueString(), p.Description.ValueString())
+		res, err := r.p.jira.Issue.Type.ScreenScheme.Update(ctx, s.ID.ValueString(), r.p.prefixedName(p.Name.ValueString()), r.p.suffixedDescription(p.Description.ValueString()))
 		if err != nil {
-			var resBody string
-			if res != nil {
-				resBody = res.Bytes.String()
-			}
-			return fmt.Errorf(" Unable to update issue type screen scheme name and description, got error: %s\n%s", err, resBody)
+			return clientErrorDiagnostics("update issue type screen scheme name and description", res, err)
 		}
 		tflog.Debug(ctx, "Updated issue type screen scheme name and description", map[string]interface{}{
 			"newNameAndDescription": fmt.Sprintf("%s, %s", p.Name.ValueString(), p.Description.ValueString()),
@@ -319,7 +349,7 @@ func (r *jiraIssueTypeScreenSchemeResource) updateNameAndDescription(ctx context
 	return nil
 }
 
-func (r *jiraIssueTypeScreenSchemeResource) updateDefaultMapping(ctx context.Context, p, s *jiraIssueTypeScreenSchemeResourceModel) error {
+func (r *jiraIssueTypeScreenSchemeResource) updateDefaultMapping(ctx context.Context, p, s *jiraIssueTypeScreenSchemeResourceModel) diag.Diagnostics {
 	var planDefaultMapping *jiraIssueTypeScreenSchemeMapping
 	for _, m := range p.IssueTypeMappings {
 		if m.IssueTypeId.ValueString() == "default" {
@@ -333,10 +363,7 @@ func (r *jiraIssueTypeScreenSchemeResource) updateDefaultMapping(ctx context.Con
 		if m.IssueTypeId.ValueString() == "default" && m.ScreenSchemeId.ValueString() != planDefaultMapping.ScreenSchemeId.ValueString() {
 			res, err := r.p.jira.Issue.Type.ScreenScheme.UpdateDefault(ctx, s.ID.ValueString(), planDefaultMapping.ScreenSchemeId.ValueString())
 			if err != nil {
-				var resBody string
-				if res != nil {
-					return fmt.Errorf(" Unable to update issue type screen scheme default mapping, got error: %s\n%s", err, resBody)
-				}
+				return clientErrorDiagnostics("update issue type screen scheme default mapping", res, err)
 			}
 			tflog.Debug(ctx, "Updated issue type screen scheme default mapping", map[string]interface{}{
 				"newDefaultMapping": fmt.Sprintf("%+v", planDefaultMapping),
@@ -347,7 +374,7 @@ func (r *jiraIssueTypeScreenSchemeResource) updateDefaultMapping(ctx context.Con
 	return nil
 }
 
-func (r *jiraIssueTypeScreenSchemeResource) addMappings(ctx context.Context, p, s *jiraIssueTypeScreenSchemeResourceModel) error {
+func (r *jiraIssueTypeScreenSchemeResource) addMappings(ctx context.Context, p, s *jiraIssueTypeScreenSchemeResourceModel) diag.Diagnostics {
 	var canAdd bool
 	for _, pm := range p.IssueTypeMappings {
 		canAdd = true
@@ -368,11 +395,7 @@ func (r *jiraIssueTypeScreenSchemeResource) addMappings(ctx context.Context, p,
 			}
 			res, err := r.p.jira.Issue.Type.ScreenScheme.Append(ctx, s.ID.ValueString(), addMappingPayload)
 			if err != nil {
-				var resBody string
-				if res != nil {
-					resBody = res.Bytes.String()
-				}
-				return fmt.Errorf(" Unable to add issue type screen scheme mapping, got error: %s\n%s", err, resBody)
+				return clientErrorDiagnostics("add issue type screen scheme mapping", res, err)
 			}
 			tflog.Debug(ctx, "Added issue type screen scheme mapping", map[string]interface{}{
 				"newMapping": fmt.Sprintf("%+v", *addMappingPayload.IssueTypeMappings[0]),
@@ -383,7 +406,7 @@ func (r *jiraIssueTypeScreenSchemeResource) addMappings(ctx context.Context, p,
 	return nil
 }
 
-func (r *jiraIssueTypeScreenSchemeResource) removeMappings(ctx context.Context, p, s *jiraIssueTypeScreenSchemeResourceModel) error {
+func (r *jiraIssueTypeScreenSchemeResource) removeMappings(ctx context.Context, p, s *jiraIssueTypeScreenSchemeResourceModel) diag.Diagnostics {
 	var removeMappings []string
 	var canRemove bool
 	for _, sm := range s.IssueTypeMappings {
@@ -401,10 +424,7 @@ func (r *jiraIssueTypeScreenSchemeResource) removeMappings(ctx context.Context,
 	if len(removeMappings) > 0 {
 		res, err := r.p.jira.Issue.Type.ScreenScheme.Remove(ctx, s.ID.ValueString(), removeMappings)
 		if err != nil {
-			var resBody string
-			if res != nil {
-				return fmt.Errorf(" Unable to remove issue type screen scheme mappings, got error: %s\n%s", err, resBody)
-			}
+			return clientErrorDiagnostics("remove issue type screen scheme mappings", res, err)
 		}
 		tflog.Debug(ctx, "Removed issue type screen scheme mappings", map[string]interface{}{
 			"removedMappings": fmt.Sprintf("%+v", removeMappings),
@@ -0,0 +1,63 @@
+package atlassian
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccJiraIssueTypeScreenSchemeAssociation_Basic(t *testing.T) {
+	randomName := acctest.RandomWithPrefix("tf-test-issue-type-screen-scheme-association")
+	resourceName = "atlassian_jira_issue_type_screen_scheme_association.test"
+	projectId := "10000"
+	// A project can only have one issue type screen scheme, so tests cannot run in parallel.
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIssueTypeScreenSchemeAssociation_basic(resourceName, randomName, projectId),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "project_id", projectId),
+					resource.TestCheckResourceAttrPair(resourceName, "issue_type_screen_scheme_id", "atlassian_jira_issue_type_screen_scheme.test", "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccIssueTypeScreenSchemeAssociationImportConfig,
+			},
+		},
+	})
+}
+
+func testAccIssueTypeScreenSchemeAssociation_basic(resourceName, name, projectId string) string {
+	splits := strings.Split(resourceName, ".")
+	return fmt.Sprintf(`
+	resource "atlassian_jira_issue_type_screen_scheme" "test" {
+		name = %[3]q
+		issue_type_mappings = [
+			{
+				issue_type_id = "default"
+				screen_scheme_id = "1"
+			},
+		]
+	}
+
+	resource %[1]q %[2]q {
+		project_id = %[4]q
+		issue_type_screen_scheme_id = atlassian_jira_issue_type_screen_scheme.test.id
+	}
+	`, splits[0], splits[1], name, projectId)
+}
+
+func testAccIssueTypeScreenSchemeAssociationImportConfig(s *terraform.State) (string, error) {
+	projectId := s.RootModule().Resources[resourceName].Primary.Attributes["project_id"]
+	schemeId := s.RootModule().Resources[resourceName].Primary.Attributes["issue_type_screen_scheme_id"]
+	return fmt.Sprintf("%s,%s", projectId, schemeId), nil
+}
@@ -2,6 +2,7 @@ package atlassian
 
 import (
 	"fmt"
+	"regexp"
 	"strings"
 	"testing"
 
@@ -198,3 +199,49 @@ func testAccJiraIssueTypeConfig_avatarid(resourceName, name, avatar_id string) s
 		avatar_id = %[4]q
 	}`, splits[0], splits[1], name, avatar_id)
 }
+
+func TestAccJiraIssueType_Scope(t *testing.T) {
+	randomName := acctest.RandomWithPrefix("tf-test-issue-type")
+	resourceName := "atlassian_jira_issue_type.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJiraIssueTypeConfig_scope(resourceName, randomName, "10003"), // team-managed project
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "scope.type", "PROJECT"),
+					resource.TestCheckResourceAttr(resourceName, "scope.project_id", "10003"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccJiraIssueType_ScopeErrors(t *testing.T) {
+	randomName := acctest.RandomWithPrefix("tf-test-issue-type")
+	resourceName := "atlassian_jira_issue_type.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccJiraIssueTypeConfig_scope(resourceName, randomName, "10000"), // company-managed project
+				ExpectError: regexp.MustCompile(`can only be used with team-managed projects`),
+			},
+		},
+	})
+}
+
+func testAccJiraIssueTypeConfig_scope(resourceName, name, projectId string) string {
+	splits := strings.Split(resourceName, ".")
+	return fmt.Sprintf(`
+	resource %[1]q %[2]q {
+		name = %[3]q
+		scope = {
+			type = "PROJECT"
+			project_id = %[4]q
+		}
+	}
+	`, splits[0], splits[1], name, projectId)
+}
@@ -0,0 +1,215 @@
+package atlassian
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+type (
+	jiraNotificationSchemeAssociationResource struct {
+		p atlassianProvider
+	}
+
+	jiraNotificationSchemeAssociationResourceModel struct {
+		ID                   types.String `tfsdk:"id"`
+		ProjectID            types.String `tfsdk:"project_id"`
+		NotificationSchemeID types.String `tfsdk:"notification_scheme_id"`
+
+		Site types.String `tfsdk:"site"`
+	}
+)
+
+var (
+	_ resource.Resource                = (*jiraNotificationSchemeAssociationResource)(nil)
+	_ resource.ResourceWithImportState = (*jiraNotificationSchemeAssociationResource)(nil)
+)
+
+func NewJiraNotificationSchemeAssociationResource() resource.Resource {
+	return &jiraNotificationSchemeAssociationResource{}
+}
+
+func (*jiraNotificationSchemeAssociationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_jira_notification_scheme_association"
+}
+
+func (*jiraNotificationSchemeAssociationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version:             1,
+		MarkdownDescription: "Jira Notification Scheme Association Resource",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the notification scheme association. " +
+					"It is computed using `project_id` and `notification_scheme_id` separated by a comma (`,`).",
+				Computed: true,
+			},
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "(Forces new resource) The ID of the project.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"notification_scheme_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the notification scheme to assign to the project. " +
+					"On destroy, the default notification scheme is assigned to the project.",
+				Required: true,
+			},
+			"site": resourceSiteAttribute(),
+		},
+	}
+}
+
+func (r *jiraNotificationSchemeAssociationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	p, ok := req.ProviderData.(*atlassianProvider)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *atlassianProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.p = *p
+}
+
+func (r *jiraNotificationSchemeAssociationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	r.p.importState(ctx, req, resp,
+		importAttribute{path.Root("project_id"), true},
+		importAttribute{path.Root("notification_scheme_id"), true},
+	)
+}
+
+func (r *jiraNotificationSchemeAssociationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Creating notification scheme association resource")
+
+	var plan jiraNotificationSchemeAssociationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx = withSite(ctx, plan.Site)
+	tflog.Debug(ctx, "Loaded notification scheme association plan", map[string]interface{}{
+		"createPlan": fmt.Sprintf("%+v", plan),
+	})
+
+	schemeId, _ := strconv.Atoi(plan.NotificationSchemeID.ValueString())
+	payload := &models.ProjectUpdateScheme{
+		NotificationScheme: schemeId,
+	}
+	_, res, err := r.p.jira.Project.Update(ctx, plan.ProjectID.ValueString(), payload)
+	if err != nil {
+		resp.Diagnostics.Append(clientErrorDiagnostics("assign notification scheme to project", res, err)...)
+		return
+	}
+	tflog.Debug(ctx, "Created notification scheme association")
+
+	plan.ID = types.StringValue(compositeID(plan.ProjectID.ValueString(), plan.NotificationSchemeID.ValueString()))
+
+	tflog.Debug(ctx, "Storing notification scheme association into the state", map[string]interface{}{
+		"createNewState": fmt.Sprintf("%+v", plan),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *jiraNotificationSchemeAssociationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Reading notification scheme association resource")
+
+	var state jiraNotificationSchemeAssociationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx = withSite(ctx, state.Site)
+	tflog.Debug(ctx, "Loaded notification scheme association from state", map[string]interface{}{
+		"readState": fmt.Sprintf("%+v", state),
+	})
+
+	notificationScheme, res, err := r.p.jira.Project.NotificationScheme(ctx, state.ProjectID.ValueString(), nil)
+	if err != nil {
+		if isNotFound(res) {
+			removeNotFoundResource(ctx, resp, "notification scheme association")
+			return
+		}
+		resp.Diagnostics.Append(clientErrorDiagnostics("get project notification scheme", res, err)...)
+		return
+	}
+	tflog.Debug(ctx, "Retrieved notification scheme association from API state")
+
+	state.NotificationSchemeID = types.StringValue(strconv.Itoa(notificationScheme.ID))
+	state.ID = types.StringValue(compositeID(state.ProjectID.ValueString(), state.NotificationSchemeID.ValueString()))
+
+	tflog.Debug(ctx, "Storing notification scheme association into the state", map[string]interface{}{
+		"readNewState": fmt.Sprintf("%+v", state),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *jiraNotificationSchemeAssociationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Updating notification scheme association resource")
+
+	var plan jiraNotificationSchemeAssociationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx = withSite(ctx, plan.Site)
+	tflog.Debug(ctx, "Loaded notification scheme association plan", map[string]interface{}{
+		"updatePlan": fmt.Sprintf("%+v", plan),
+	})
+
+	schemeId, _ := strconv.Atoi(plan.NotificationSchemeID.ValueString())
+	payload := &models.ProjectUpdateScheme{
+		NotificationScheme: schemeId,
+	}
+	_, res, err := r.p.jira.Project.Update(ctx, plan.ProjectID.ValueString(), payload)
+	if err != nil {
+		resp.Diagnostics.Append(clientErrorDiagnostics("assign notification scheme to project", res, err)...)
+		return
+	}
+	tflog.Debug(ctx, "Updated notification scheme association in API state")
+
+	plan.ID = types.StringValue(compositeID(plan.ProjectID.ValueString(), plan.NotificationSchemeID.ValueString()))
+
+	tflog.Debug(ctx, "Storing notification scheme association into the state")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *jiraNotificationSchemeAssociationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Deleting notification scheme association resource")
+
+	var state jiraNotificationSchemeAssociationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded notification scheme association from state")
+	ctx = withSite(ctx, state.Site)
+
+	defaultSchemeId, _ := strconv.Atoi(jiraDefaultNotificationSchemeID)
+	payload := &models.ProjectUpdateScheme{
+		NotificationScheme: defaultSchemeId,
+	}
+	_, res, err := r.p.jira.Project.Update(ctx, state.ProjectID.ValueString(), payload)
+	if err != nil {
+		resp.Diagnostics.Append(clientErrorDiagnostics("assign default notification scheme to project", res, err)...)
+		return
+	}
+	tflog.Debug(ctx, "Deleted notification scheme association from API state")
+
+	// If a Resource type Delete method is completed without error, the framework will automatically remove the resource.
+}
@@ -0,0 +1,54 @@
+package atlassian
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccJiraNotificationSchemeAssociation_Basic(t *testing.T) {
+	// The provider does not manage notification schemes, so the scheme is an existing one.
+	schemeId := testAccEnv(t, "ATLASSIAN_NOTIFICATION_SCHEME_ID")
+	resourceName = "atlassian_jira_notification_scheme_association.test"
+	projectId := "10000"
+	// A project can only have one notification scheme, so tests cannot run in parallel.
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNotificationSchemeAssociation_basic(resourceName, projectId, schemeId),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "project_id", projectId),
+					resource.TestCheckResourceAttr(resourceName, "notification_scheme_id", schemeId),
+					resource.TestCheckResourceAttr(resourceName, "id", compositeID(projectId, schemeId)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccNotificationSchemeAssociationImportConfig,
+			},
+		},
+	})
+}
+
+func testAccNotificationSchemeAssociation_basic(resourceName, projectId, schemeId string) string {
+	splits := strings.Split(resourceName, ".")
+	return fmt.Sprintf(`
+	resource %[1]q %[2]q {
+		project_id = %[3]q
+		notification_scheme_id = %[4]q
+	}
+	`, splits[0], splits[1], projectId, schemeId)
+}
+
+func testAccNotificationSchemeAssociationImportConfig(s *terraform.State) (string, error) {
+	projectId := s.RootModule().Resources[resourceName].Primary.Attributes["project_id"]
+	schemeId := s.RootModule().Resources[resourceName].Primary.Attributes["notification_scheme_id"]
+	return fmt.Sprintf("%s,%s", projectId, schemeId), nil
+}
@@ -4,9 +4,7 @@ import (
 	"context"
 	"fmt"
 	"strconv"
-	"strings"
 
-	jira "github.com/ctreminiom/go-atlassian/jira/v3"
 	"github.com/ctreminiom/go-atlassian/pkg/infra/models"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -31,6 +29,8 @@ type (
 		PermissionSchemeID types.String                    `tfsdk:"permission_scheme_id"`
 		Holder             *jiraPermissionGrantHolderModel `tfsdk:"holder"`
 		Permission         types.String                    `tfsdk:"permission"`
+
+		Site types.String `tfsdk:"site"`
 	}
 
 	jiraPermissionGrantHolderModel struct {
@@ -132,6 +132,7 @@ func (*jiraPermissionGrantResource) Schema(_ context.Context, _ resource.SchemaR
 					stringvalidator.OneOf(built_in_permissions...),
 				},
 			},
+			"site": resourceSiteAttribute(),
 		},
 	}
 }
@@ -142,29 +143,23 @@ func (r *jiraPermissionGrantResource) Configure(ctx context.Context, req resourc
 		return
 	}
 
-	client, ok := req.ProviderData.(*jira.Client)
+	p, ok := req.ProviderData.(*atlassianProvider)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *jira.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *atlassianProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	r.p.jira = client
+	r.p = *p
 }
 
-func (*jiraPermissionGrantResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, ",")
-	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-		resp.Diagnostics.AddError("Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: ID, permission_scheme_id. Got: %q", req.ID))
-		return
-	}
-	tflog.Debug(ctx, fmt.Sprintf("Importing permission grant with import identifier: %+v", idParts))
-
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idParts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("permission_scheme_id"), idParts[1])...)
+func (r *jiraPermissionGrantResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	r.p.importState(ctx, req, resp,
+		importAttribute{path.Root("id"), true},
+		importAttribute{path.Root("permission_scheme_id"), true},
+	)
 }
 
 func (r *jiraPermissionGrantResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -175,6 +170,7 @@ func (r *jiraPermissionGrantResource) Create(ctx context.Context, req resource.C
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	ctx = withSite(ctx, plan.Site)
 	tflog.Debug(ctx, "Loaded permission grant plan", map[string]interface{}{
 		"createPlan": fmt.Sprintf("%+v, Holder:%+v", plan, plan.Holder),
 	})
@@ -203,11 +199,7 @@ func (r *jiraPermissionGrantResource) Create(ctx context.Context, req resource.C
 
 	permissionGrant, res, err := r.p.jira.Permission.Scheme.Grant.Create(ctx, schemeId, createPayload)
 	if err != nil {
-		var resBody string
-		if res != nil {
-			resBody = res.Bytes.String()
-		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create permission grant, got error: %s\n%s", err, resBody))
+		resp.Diagnostics.Append(clientErrorDiagnostics("create permission grant", res, err)...)
 		return
 	}
 	tflog.Debug(ctx, "Created permission grant")
@@ -228,6 +220,7 @@ func (r *jiraPermissionGrantResource) Read(ctx context.Context, req resource.Rea
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	ctx = withSite(ctx, state.Site)
 	tflog.Debug(ctx, "Loaded permission grant from state", map[string]interface{}{
 		"readState": fmt.Sprintf("%+v, Holder:%+v", state, state.Holder),
 	})
@@ -237,11 +230,11 @@ func (r *jiraPermissionGrantResource) Read(ctx context.Context, req resource.Rea
 
 	permissionGrant, res, err := r.p.jira.Permission.Scheme.Grant.Get(ctx, schemeId, grantId, []string{"all"})
 	if err != nil {
-		var resBody string
-		if res != nil {
-			resBody = res.Bytes.String()
+		if isNotFound(res) {
+			removeNotFoundResource(ctx, resp, "permission grant")
+			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get permission grant, got error: %s\n%s", err, resBody))
+		resp.Diagnostics.Append(clientErrorDiagnostics("get permission grant", res, err)...)
 		return
 	}
 	tflog.Debug(ctx, "Retrieved permission grant from API state")
@@ -272,17 +265,14 @@ func (r *jiraPermissionGrantResource) Delete(ctx context.Context, req resource.D
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	ctx = withSite(ctx, state.Site)
 
 	grantId, _ := strconv.Atoi(state.ID.ValueString())
 	schemeId, _ := strconv.Atoi(state.PermissionSchemeID.ValueString())
 
 	res, err := r.p.jira.Permission.Scheme.Grant.Delete(ctx, schemeId, grantId)
 	if err != nil {
-		var resBody string
-		if res != nil {
-			resBody = res.Bytes.String()
-		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete permission grant, got error: %s\n%s", err, resBody))
+		resp.Diagnostics.Append(clientErrorDiagnostics("delete permission grant", res, err)...)
 		return
 	}
 	tflog.Debug(ctx, "Deleted permission grant from API state")
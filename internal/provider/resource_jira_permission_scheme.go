@@ -4,10 +4,11 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"strings"
 
-	jira "github.com/ctreminiom/go-atlassian/jira/v3"
 	"github.com/ctreminiom/go-atlassian/pkg/infra/models"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -15,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/openscientia/terraform-provider-atlassian/internal/provider/planmodifiers/stringmodifiers"
 )
@@ -29,12 +31,25 @@ type (
 		Self        types.String `tfsdk:"self"`
 		Name        types.String `tfsdk:"name"`
 		Description types.String `tfsdk:"description"`
+		Permissions types.Set    `tfsdk:"permissions"`
+
+		DeletionProtection types.Bool `tfsdk:"deletion_protection"`
+		ForceDetach        types.Bool `tfsdk:"force_detach"`
+
+		Site types.String `tfsdk:"site"`
+	}
+
+	jiraPermissionSchemeGrantModel struct {
+		Holder     *jiraPermissionGrantHolderModel `tfsdk:"holder"`
+		Permission types.String                    `tfsdk:"permission"`
 	}
 )
 
 var (
-	_ resource.Resource                = (*jiraPermissionSchemeResource)(nil)
-	_ resource.ResourceWithImportState = (*jiraPermissionSchemeResource)(nil)
+	_ resource.Resource                   = (*jiraPermissionSchemeResource)(nil)
+	_ resource.ResourceWithImportState    = (*jiraPermissionSchemeResource)(nil)
+	_ resource.ResourceWithValidateConfig = (*jiraPermissionSchemeResource)(nil)
+	_ resource.ResourceWithModifyPlan     = (*jiraPermissionSchemeResource)(nil)
 )
 
 func NewJiraPermissionSchemeResource() resource.Resource {
@@ -80,6 +95,45 @@ func (*jiraPermissionSchemeResource) Schema(_ context.Context, _ resource.Schema
 					stringmodifiers.DefaultValue(""),
 				},
 			},
+			"permissions": schema.SetNestedAttribute{
+				MarkdownDescription: "The permission grants of the permission scheme. " +
+					"When set, the permission scheme is authoritative and any grant not listed is removed. " +
+					"Do not use together with `atlassian_jira_permission_grant` resources for the same permission scheme.",
+				Optional: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"holder": schema.SingleNestedAttribute{
+							MarkdownDescription: "The user, group, field or role being granted the permission.",
+							Required:            true,
+							Attributes: map[string]schema.Attribute{
+								"type": schema.StringAttribute{
+									MarkdownDescription: "The type of permission holder. " +
+										"Can be one of: `anyone`, `applicationRole`, `assignee`, `group`, `groupCustomField`, " +
+										"`projectLead`, `projectRole`, `reporter`, `user` or `userCustomField`.",
+									Required: true,
+									Validators: []validator.String{
+										stringvalidator.OneOf(holder_types...),
+									},
+								},
+								"parameter": schema.StringAttribute{
+									MarkdownDescription: "The identifier associated with the `type` value that defines the holder of the permission.",
+									Optional:            true,
+								},
+							},
+						},
+						"permission": schema.StringAttribute{
+							MarkdownDescription: "The permission to grant. Must be one of the [built-in permissions](https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-permission-schemes/#built-in-permissions), e.g. `BROWSE_PROJECTS`.",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(built_in_permissions...),
+							},
+						},
+					},
+				},
+			},
+			"deletion_protection": deletionProtectionAttribute("permission scheme"),
+			"force_detach":        forceDetachAttribute("permission scheme"),
+			"site":                resourceSiteAttribute(),
 		},
 	}
 }
@@ -90,20 +144,60 @@ func (r *jiraPermissionSchemeResource) Configure(ctx context.Context, req resour
 		return
 	}
 
-	client, ok := req.ProviderData.(*jira.Client)
+	p, ok := req.ProviderData.(*atlassianProvider)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *jira.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *atlassianProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	r.p.jira = client
+	r.p = *p
 }
 
-func (*jiraPermissionSchemeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+func (r *jiraPermissionSchemeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	r.p.importState(ctx, req, resp, importAttribute{path.Root("id"), true})
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Read only fills the grants when permissions is set, so it is set to import them too
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("permissions"), []jiraPermissionSchemeGrantModel{})...)
+}
+
+func (*jiraPermissionSchemeResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config jiraPermissionSchemeResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Permissions.IsNull() || config.Permissions.IsUnknown() {
+		return
+	}
+
+	resp.Diagnostics.Append(validatePermissionSchemeGrants(ctx, config.Permissions)...)
+}
+
+func (r *jiraPermissionSchemeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Replacing the permission scheme deletes it first
+	resp.Diagnostics.Append(replacePlanDiagnostics(ctx, "permission scheme", req, resp)...)
+
+	// Otherwise, only the plans to destroy the permission scheme are checked
+	if !req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var state jiraPermissionSchemeResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Finding the projects using a permission scheme requests the permission scheme of every
+	// project of the site, so they are only checked when the permission scheme is deleted
+	resp.Diagnostics.Append(destroyPlanDiagnostics(ctx, "permission scheme", state.Name.ValueString(), state.DeletionProtection.ValueBool(), state.ForceDetach.ValueBool(), nil)...)
 }
 
 func (r *jiraPermissionSchemeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -114,23 +208,37 @@ func (r *jiraPermissionSchemeResource) Create(ctx context.Context, req resource.
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	ctx = withSite(ctx, plan.Site)
 	tflog.Debug(ctx, "Loaded permission scheme plan", map[string]interface{}{
 		"createPlan": fmt.Sprintf("%+v", plan),
 	})
 
+	var grants []jiraPermissionSchemeGrantModel
+	if !plan.Permissions.IsNull() && !plan.Permissions.IsUnknown() {
+		resp.Diagnostics.Append(plan.Permissions.ElementsAs(ctx, &grants, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	createPayload := &models.PermissionSchemeScheme{
 		Expand:      "all",
-		Name:        plan.Name.ValueString(),
-		Description: plan.Description.ValueString(),
+		Name:        r.p.prefixedName(plan.Name.ValueString()),
+		Description: r.p.suffixedDescription(plan.Description.ValueString()),
+	}
+	for _, g := range grants {
+		createPayload.Permissions = append(createPayload.Permissions, &models.PermissionGrantScheme{
+			Holder: &models.PermissionGrantHolderScheme{
+				Type:      g.Holder.Type.ValueString(),
+				Parameter: g.Holder.Parameter.ValueString(),
+			},
+			Permission: g.Permission.ValueString(),
+		})
 	}
 
 	permissionScheme, res, err := r.p.jira.Permission.Scheme.Create(ctx, createPayload)
 	if err != nil {
-		var resBody string
-		if res != nil {
-			resBody = res.Bytes.String()
-		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create permission scheme, got error: %s\n%s", err, resBody))
+		resp.Diagnostics.Append(clientErrorDiagnostics("create permission scheme", res, err)...)
 		return
 	}
 	tflog.Debug(ctx, "Created permission scheme in API state")
@@ -152,6 +260,7 @@ func (r *jiraPermissionSchemeResource) Read(ctx context.Context, req resource.Re
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	ctx = withSite(ctx, state.Site)
 	tflog.Debug(ctx, "Loaded permission scheme from state", map[string]interface{}{
 		"readState": fmt.Sprintf("%+v", state),
 	})
@@ -160,18 +269,37 @@ func (r *jiraPermissionSchemeResource) Read(ctx context.Context, req resource.Re
 
 	permissionScheme, res, err := r.p.jira.Permission.Scheme.Get(ctx, schemeId, []string{""})
 	if err != nil {
-		var resBody string
-		if res != nil {
-			resBody = res.Bytes.String()
+		if isNotFound(res) {
+			removeNotFoundResource(ctx, resp, "permission scheme")
+			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get permission scheme, got error: %s\n%s", err, resBody))
+		resp.Diagnostics.Append(clientErrorDiagnostics("get permission scheme", res, err)...)
 		return
 	}
 	tflog.Debug(ctx, "Retrieved permission scheme from API state")
 
 	state.Self = types.StringValue(permissionScheme.Self)
-	state.Name = types.StringValue(permissionScheme.Name)
-	state.Description = types.StringValue(permissionScheme.Description)
+	state.Name = types.StringValue(r.p.unprefixedName(permissionScheme.Name))
+	state.Description = types.StringValue(r.p.unsuffixedDescription(permissionScheme.Description))
+
+	// Permission grants are only reconciled when managed by this resource.
+	if !state.Permissions.IsNull() {
+		grants, diags := r.readPermissionSchemeGrants(ctx, schemeId)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		permissions := []jiraPermissionSchemeGrantModel{}
+		for _, g := range grants {
+			permissions = append(permissions, newJiraPermissionSchemeGrantModel(g))
+		}
+		state.Permissions, diags = types.SetValueFrom(ctx, state.Permissions.ElementType(ctx), permissions)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
 
 	tflog.Debug(ctx, "Storing permission scheme into the state", map[string]interface{}{
 		"readNewState": fmt.Sprintf("%+v", state),
@@ -187,6 +315,7 @@ func (r *jiraPermissionSchemeResource) Update(ctx context.Context, req resource.
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	ctx = withSite(ctx, plan.Site)
 	tflog.Debug(ctx, "Loaded permission scheme plan", map[string]interface{}{
 		"updatePlan": fmt.Sprintf("%+v", plan),
 	})
@@ -202,21 +331,32 @@ func (r *jiraPermissionSchemeResource) Update(ctx context.Context, req resource.
 
 	schemeId, _ := strconv.Atoi(state.ID.ValueString())
 
+	var grants []jiraPermissionSchemeGrantModel
+	if !plan.Permissions.IsNull() && !plan.Permissions.IsUnknown() {
+		resp.Diagnostics.Append(plan.Permissions.ElementsAs(ctx, &grants, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	updatePayload := &models.PermissionSchemeScheme{
 		ID:          schemeId,
-		Name:        plan.Name.ValueString(),
-		Description: plan.Description.ValueString(),
+		Name:        r.p.prefixedName(plan.Name.ValueString()),
+		Description: r.p.suffixedDescription(plan.Description.ValueString()),
 	}
 
 	_, res, err := r.p.jira.Permission.Scheme.Update(ctx, schemeId, updatePayload)
 	if err != nil {
-		var resBody string
-		if res != nil {
-			resBody = res.Bytes.String()
-		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update permission scheme, got error: %s\n%s", err, resBody))
+		resp.Diagnostics.Append(clientErrorDiagnostics("update permission scheme", res, err)...)
 		return
 	}
+
+	if !plan.Permissions.IsNull() && !plan.Permissions.IsUnknown() {
+		resp.Diagnostics.Append(r.reconcilePermissionSchemeGrants(ctx, schemeId, grants)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
 	tflog.Debug(ctx, "Updated permission scheme in API state")
 
 	tflog.Debug(ctx, "Storing permission scheme into the state")
@@ -232,19 +372,153 @@ func (r *jiraPermissionSchemeResource) Delete(ctx context.Context, req resource.
 		return
 	}
 	tflog.Debug(ctx, "Loaded permission scheme from state")
+	ctx = withSite(ctx, state.Site)
+
+	if state.DeletionProtection.ValueBool() {
+		resp.Diagnostics.Append(deletionProtectionDiagnostics("permission scheme", state.Name.ValueString())...)
+		return
+	}
+
+	resp.Diagnostics.Append(jiraPermissionSchemeType.detachProjects(ctx, r.p.jira, state.ID.ValueString(), state.Name.ValueString(), state.ForceDetach.ValueBool())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	schemeId, _ := strconv.Atoi(state.ID.ValueString())
 
 	res, err := r.p.jira.Permission.Scheme.Delete(ctx, schemeId)
 	if err != nil {
-		var resBody string
-		if res != nil {
-			resBody = res.Bytes.String()
-		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete permission scheme, got error: %s\n%s", err, resBody))
+		resp.Diagnostics.Append(clientErrorDiagnostics("delete permission scheme", res, err)...)
 		return
 	}
 	tflog.Debug(ctx, "Deleted permission scheme from API state")
 
 	// If a Resource type Delete method is completed without error, the framework will automatically remove the resource.
 }
+
+func (r *jiraPermissionSchemeResource) readPermissionSchemeGrants(ctx context.Context, schemeId int) ([]*models.PermissionGrantScheme, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	permissionGrants, res, err := r.p.jira.Permission.Scheme.Grant.Gets(ctx, schemeId, []string{""})
+	if err != nil {
+		diags.Append(clientErrorDiagnostics("get permission scheme grants", res, err)...)
+		return nil, diags
+	}
+	tflog.Debug(ctx, "Retrieved permission scheme grants from API state", map[string]interface{}{
+		"grantsCount": len(permissionGrants.Permissions),
+	})
+
+	return permissionGrants.Permissions, diags
+}
+
+// reconcilePermissionSchemeGrants deletes the grants of the permission scheme that are not in the plan
+// and creates the planned grants that do not exist yet. Grants present in both are left untouched.
+func (r *jiraPermissionSchemeResource) reconcilePermissionSchemeGrants(ctx context.Context, schemeId int, planned []jiraPermissionSchemeGrantModel) diag.Diagnostics {
+	current, diags := r.readPermissionSchemeGrants(ctx, schemeId)
+	if diags.HasError() {
+		return diags
+	}
+
+	wanted := make(map[string]jiraPermissionSchemeGrantModel, len(planned))
+	for _, g := range planned {
+		wanted[permissionSchemeGrantKey(g.Holder.Type.ValueString(), g.Holder.Parameter.ValueString(), g.Permission.ValueString())] = g
+	}
+
+	existing := make(map[string]bool, len(current))
+	for _, g := range current {
+		key := permissionSchemeGrantKey(g.Holder.Type, g.Holder.Parameter, g.Permission)
+		if _, ok := wanted[key]; ok && !existing[key] {
+			existing[key] = true
+			continue
+		}
+
+		res, err := r.p.jira.Permission.Scheme.Grant.Delete(ctx, schemeId, g.ID)
+		if err != nil {
+			diags.Append(clientErrorDiagnostics("delete permission scheme grant", res, err)...)
+			return diags
+		}
+		tflog.Debug(ctx, "Deleted permission scheme grant", map[string]interface{}{
+			"deletedGrant": key,
+		})
+	}
+
+	for key, g := range wanted {
+		if existing[key] {
+			continue
+		}
+
+		payload := &models.PermissionGrantPayloadScheme{
+			Holder: &models.PermissionGrantHolderScheme{
+				Type:      g.Holder.Type.ValueString(),
+				Parameter: g.Holder.Parameter.ValueString(),
+			},
+			Permission: g.Permission.ValueString(),
+		}
+		_, res, err := r.p.jira.Permission.Scheme.Grant.Create(ctx, schemeId, payload)
+		if err != nil {
+			diags.Append(clientErrorDiagnostics("create permission scheme grant", res, err)...)
+			return diags
+		}
+		tflog.Debug(ctx, "Created permission scheme grant", map[string]interface{}{
+			"createdGrant": key,
+		})
+	}
+
+	return diags
+}
+
+// validatePermissionSchemeGrants checks that the grants of the permissions set whose holder type
+// requires a parameter set it. Grants with unknown values are skipped.
+func validatePermissionSchemeGrants(ctx context.Context, permissions types.Set) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	specialTypes := []string{"group", "projectRole", "user", "userCustomField"}
+	for _, v := range permissions.Elements() {
+		element, ok := v.(types.Object)
+		if !ok || element.IsNull() || element.IsUnknown() {
+			continue
+		}
+		var g jiraPermissionSchemeGrantModel
+		diags.Append(element.As(ctx, &g, basetypes.ObjectAsOptions{UnhandledUnknownAsEmpty: true})...)
+		if diags.HasError() {
+			return diags
+		}
+		if g.Holder == nil || g.Holder.Type.IsUnknown() || g.Holder.Parameter.IsUnknown() {
+			continue
+		}
+
+		for _, st := range specialTypes {
+			if g.Holder.Type.ValueString() == st && g.Holder.Parameter.ValueString() == "" {
+				diags.AddAttributeError(path.Root("permissions").AtSetValue(element).AtName("holder").AtName("parameter"),
+					"Failed to provide a value for \"holder.parameter\" attribute",
+					fmt.Sprintf("Value must be provided if \"holder.type\" is: %s (permission: %s)", st, g.Permission.ValueString()),
+				)
+			}
+		}
+	}
+
+	return diags
+}
+
+func newJiraPermissionSchemeGrantModel(g *models.PermissionGrantScheme) jiraPermissionSchemeGrantModel {
+	m := jiraPermissionSchemeGrantModel{
+		Holder: &jiraPermissionGrantHolderModel{
+			Type:      types.StringNull(),
+			Parameter: types.StringNull(),
+		},
+		Permission: types.StringValue(g.Permission),
+	}
+	if g.Holder != nil {
+		m.Holder.Type = types.StringValue(g.Holder.Type)
+		// Holders without a parameter, e.g. "anyone", are stored as null to match an omitted attribute.
+		if g.Holder.Parameter != "" {
+			m.Holder.Parameter = types.StringValue(g.Holder.Parameter)
+		}
+	}
+
+	return m
+}
+
+func permissionSchemeGrantKey(holderType, holderParameter, permission string) string {
+	return strings.Join([]string{holderType, holderParameter, permission}, "|")
+}
@@ -0,0 +1,205 @@
+package atlassian
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+type (
+	jiraPermissionSchemeAssociationResource struct {
+		p atlassianProvider
+	}
+
+	jiraPermissionSchemeAssociationResourceModel struct {
+		ID                 types.String `tfsdk:"id"`
+		ProjectID          types.String `tfsdk:"project_id"`
+		PermissionSchemeID types.String `tfsdk:"permission_scheme_id"`
+
+		Site types.String `tfsdk:"site"`
+	}
+)
+
+var (
+	_ resource.Resource                = (*jiraPermissionSchemeAssociationResource)(nil)
+	_ resource.ResourceWithImportState = (*jiraPermissionSchemeAssociationResource)(nil)
+)
+
+func NewJiraPermissionSchemeAssociationResource() resource.Resource {
+	return &jiraPermissionSchemeAssociationResource{}
+}
+
+func (*jiraPermissionSchemeAssociationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_jira_permission_scheme_association"
+}
+
+func (*jiraPermissionSchemeAssociationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version:             1,
+		MarkdownDescription: "Jira Permission Scheme Association Resource",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the permission scheme association. " +
+					"It is computed using `project_id` and `permission_scheme_id` separated by a comma (`,`).",
+				Computed: true,
+			},
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "(Forces new resource) The ID of the project.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"permission_scheme_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the permission scheme to assign to the project. " +
+					"On destroy, the default permission scheme is assigned to the project.",
+				Required: true,
+			},
+			"site": resourceSiteAttribute(),
+		},
+	}
+}
+
+func (r *jiraPermissionSchemeAssociationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	p, ok := req.ProviderData.(*atlassianProvider)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *atlassianProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.p = *p
+}
+
+func (r *jiraPermissionSchemeAssociationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	r.p.importState(ctx, req, resp,
+		importAttribute{path.Root("project_id"), true},
+		importAttribute{path.Root("permission_scheme_id"), true},
+	)
+}
+
+func (r *jiraPermissionSchemeAssociationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Creating permission scheme association resource")
+
+	var plan jiraPermissionSchemeAssociationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx = withSite(ctx, plan.Site)
+	tflog.Debug(ctx, "Loaded permission scheme association plan", map[string]interface{}{
+		"createPlan": fmt.Sprintf("%+v", plan),
+	})
+
+	schemeId, _ := strconv.Atoi(plan.PermissionSchemeID.ValueString())
+	_, res, err := r.p.jira.Project.Permission.Assign(ctx, plan.ProjectID.ValueString(), schemeId)
+	if err != nil {
+		resp.Diagnostics.Append(clientErrorDiagnostics("assign permission scheme to project", res, err)...)
+		return
+	}
+	tflog.Debug(ctx, "Created permission scheme association")
+
+	plan.ID = types.StringValue(compositeID(plan.ProjectID.ValueString(), plan.PermissionSchemeID.ValueString()))
+
+	tflog.Debug(ctx, "Storing permission scheme association into the state", map[string]interface{}{
+		"createNewState": fmt.Sprintf("%+v", plan),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *jiraPermissionSchemeAssociationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Reading permission scheme association resource")
+
+	var state jiraPermissionSchemeAssociationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx = withSite(ctx, state.Site)
+	tflog.Debug(ctx, "Loaded permission scheme association from state", map[string]interface{}{
+		"readState": fmt.Sprintf("%+v", state),
+	})
+
+	permissionScheme, res, err := r.p.jira.Project.Permission.Get(ctx, state.ProjectID.ValueString(), nil)
+	if err != nil {
+		if isNotFound(res) {
+			removeNotFoundResource(ctx, resp, "permission scheme association")
+			return
+		}
+		resp.Diagnostics.Append(clientErrorDiagnostics("get project permission scheme", res, err)...)
+		return
+	}
+	tflog.Debug(ctx, "Retrieved permission scheme association from API state")
+
+	state.PermissionSchemeID = types.StringValue(strconv.Itoa(permissionScheme.ID))
+	state.ID = types.StringValue(compositeID(state.ProjectID.ValueString(), state.PermissionSchemeID.ValueString()))
+
+	tflog.Debug(ctx, "Storing permission scheme association into the state", map[string]interface{}{
+		"readNewState": fmt.Sprintf("%+v", state),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *jiraPermissionSchemeAssociationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Updating permission scheme association resource")
+
+	var plan jiraPermissionSchemeAssociationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx = withSite(ctx, plan.Site)
+	tflog.Debug(ctx, "Loaded permission scheme association plan", map[string]interface{}{
+		"updatePlan": fmt.Sprintf("%+v", plan),
+	})
+
+	schemeId, _ := strconv.Atoi(plan.PermissionSchemeID.ValueString())
+	_, res, err := r.p.jira.Project.Permission.Assign(ctx, plan.ProjectID.ValueString(), schemeId)
+	if err != nil {
+		resp.Diagnostics.Append(clientErrorDiagnostics("assign permission scheme to project", res, err)...)
+		return
+	}
+	tflog.Debug(ctx, "Updated permission scheme association in API state")
+
+	plan.ID = types.StringValue(compositeID(plan.ProjectID.ValueString(), plan.PermissionSchemeID.ValueString()))
+
+	tflog.Debug(ctx, "Storing permission scheme association into the state")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *jiraPermissionSchemeAssociationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Deleting permission scheme association resource")
+
+	var state jiraPermissionSchemeAssociationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded permission scheme association from state")
+	ctx = withSite(ctx, state.Site)
+
+	defaultSchemeId, _ := strconv.Atoi(jiraDefaultPermissionSchemeID)
+	_, res, err := r.p.jira.Project.Permission.Assign(ctx, state.ProjectID.ValueString(), defaultSchemeId)
+	if err != nil {
+		resp.Diagnostics.Append(clientErrorDiagnostics("assign default permission scheme to project", res, err)...)
+		return
+	}
+	tflog.Debug(ctx, "Deleted permission scheme association from API state")
+
+	// If a Resource type Delete method is completed without error, the framework will automatically remove the resource.
+}
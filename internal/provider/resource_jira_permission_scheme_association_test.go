@@ -0,0 +1,57 @@
+package atlassian
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccJiraPermissionSchemeAssociation_Basic(t *testing.T) {
+	randomName := acctest.RandomWithPrefix("tf-test-permission-scheme-association")
+	resourceName = "atlassian_jira_permission_scheme_association.test"
+	projectId := "10000"
+	// A project can only have one permission scheme, so tests cannot run in parallel.
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPermissionSchemeAssociation_basic(resourceName, randomName, projectId),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "project_id", projectId),
+					resource.TestCheckResourceAttrPair(resourceName, "permission_scheme_id", "atlassian_jira_permission_scheme.test", "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccPermissionSchemeAssociationImportConfig,
+			},
+		},
+	})
+}
+
+func testAccPermissionSchemeAssociation_basic(resourceName, name, projectId string) string {
+	splits := strings.Split(resourceName, ".")
+	return fmt.Sprintf(`
+	resource "atlassian_jira_permission_scheme" "test" {
+		name = %[3]q
+	}
+
+	resource %[1]q %[2]q {
+		project_id = %[4]q
+		permission_scheme_id = atlassian_jira_permission_scheme.test.id
+	}
+	`, splits[0], splits[1], name, projectId)
+}
+
+func testAccPermissionSchemeAssociationImportConfig(s *terraform.State) (string, error) {
+	projectId := s.RootModule().Resources[resourceName].Primary.Attributes["project_id"]
+	schemeId := s.RootModule().Resources[resourceName].Primary.Attributes["permission_scheme_id"]
+	return fmt.Sprintf("%s,%s", projectId, schemeId), nil
+}
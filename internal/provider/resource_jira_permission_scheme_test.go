@@ -1,14 +1,74 @@
 package atlassian
 
 import (
+	"context"
 	"fmt"
+	"regexp"
 	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
 
+func TestJiraPermissionSchemeResource_ValidateConfig(t *testing.T) {
+	ctx := context.Background()
+
+	tests := map[string]struct {
+		holder    jiraPermissionGrantHolderModel
+		wantError bool
+	}{
+		"parameter":         {holder: jiraPermissionGrantHolderModel{Type: types.StringValue("group"), Parameter: types.StringValue("jira-administrators")}},
+		"no parameter":      {holder: jiraPermissionGrantHolderModel{Type: types.StringValue("anyone"), Parameter: types.StringNull()}},
+		"missing parameter": {holder: jiraPermissionGrantHolderModel{Type: types.StringValue("group"), Parameter: types.StringNull()}, wantError: true},
+		"unknown parameter": {holder: jiraPermissionGrantHolderModel{Type: types.StringValue("group"), Parameter: types.StringUnknown()}},
+		"unknown type":      {holder: jiraPermissionGrantHolderModel{Type: types.StringUnknown(), Parameter: types.StringNull()}},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			r := NewJiraPermissionSchemeResource()
+			state := testResourceState(t, r, map[string]string{"name": "test"})
+			grants := []jiraPermissionSchemeGrantModel{
+				{Holder: &jiraPermissionGrantHolderModel{Type: types.StringValue("anyone"), Parameter: types.StringNull()}, Permission: types.StringValue("BROWSE_PROJECTS")},
+				{Holder: &tt.holder, Permission: types.StringValue("ADMINISTER_PROJECTS")},
+			}
+			if diags := state.SetAttribute(ctx, path.Root("permissions"), grants); diags.HasError() {
+				t.Fatalf("unable to set permissions: %v", diags)
+			}
+
+			resp := &fwresource.ValidateConfigResponse{}
+			r.(fwresource.ResourceWithValidateConfig).ValidateConfig(ctx, fwresource.ValidateConfigRequest{Config: tfsdk.Config{Schema: state.Schema, Raw: state.Raw}}, resp)
+			if got := resp.Diagnostics.HasError(); got != tt.wantError {
+				t.Fatalf("expected error %t, got: %v", tt.wantError, resp.Diagnostics)
+			}
+			if !tt.wantError {
+				return
+			}
+
+			// The error is attached to the grant missing the parameter
+			var permissions types.Set
+			if diags := state.GetAttribute(ctx, path.Root("permissions"), &permissions); diags.HasError() {
+				t.Fatalf("unable to get permissions: %v", diags)
+			}
+			var element types.Object
+			for _, v := range permissions.Elements() {
+				if o := v.(types.Object); strings.Contains(o.String(), "ADMINISTER_PROJECTS") {
+					element = o
+				}
+			}
+			want := path.Root("permissions").AtSetValue(element).AtName("holder").AtName("parameter")
+			if d, ok := resp.Diagnostics.Errors()[0].(diag.DiagnosticWithPath); !ok || !d.Path().Equal(want) {
+				t.Errorf("expected error attached to %s, got: %v", want, resp.Diagnostics.Errors()[0])
+			}
+		})
+	}
+}
+
 func TestAccJiraPermissionScheme_Basic(t *testing.T) {
 	randomName := acctest.RandomWithPrefix("tf-test-permission-scheme")
 	resourceName := "atlassian_jira_permission_scheme.test"
@@ -29,6 +89,8 @@ func TestAccJiraPermissionScheme_Basic(t *testing.T) {
 				ResourceName:      resourceName,
 				ImportState:       true,
 				ImportStateVerify: true,
+				// The grants are imported in permissions, which is not set in the configuration
+				ImportStateVerifyIgnore: []string{"permissions"},
 			},
 		},
 	})
@@ -80,6 +142,75 @@ func TestAccJiraPermissionScheme_Description(t *testing.T) {
 	})
 }
 
+func TestAccJiraPermissionScheme_Permissions(t *testing.T) {
+	randomName := acctest.RandomWithPrefix("tf-test-permission-scheme")
+	resourceName := "atlassian_jira_permission_scheme.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPermissionScheme_permissions(resourceName, randomName, "BROWSE_PROJECTS"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "permissions.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "permissions.*", map[string]string{
+						"holder.type": "anyone",
+						"permission":  "BROWSE_PROJECTS",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "permissions.*", map[string]string{
+						"holder.type":      "projectRole",
+						"holder.parameter": "10002",
+						"permission":       "ADMINISTER_PROJECTS",
+					}),
+				),
+			},
+			{
+				Config: testAccPermissionScheme_permissions(resourceName, randomName, "CREATE_ISSUES"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "permissions.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "permissions.*", map[string]string{
+						"holder.type": "anyone",
+						"permission":  "CREATE_ISSUES",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccJiraPermissionScheme_DeletionProtection(t *testing.T) {
+	randomName := acctest.RandomWithPrefix("tf-test-permission-scheme")
+	resourceName := "atlassian_jira_permission_scheme.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPermissionScheme_deletionProtection(resourceName, randomName, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "deletion_protection", "true"),
+				),
+			},
+			{
+				Config:      testAccPermissionScheme_deletionProtection(resourceName, randomName, true),
+				Destroy:     true,
+				ExpectError: regexp.MustCompile("Deletion Protection Enabled"),
+			},
+			{
+				Config: testAccPermissionScheme_deletionProtection(resourceName, randomName, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "deletion_protection", "false"),
+				),
+			},
+		},
+	})
+}
+
 func testAccPermissionScheme_basic(resourceName, name string) string {
 	splits := strings.Split(resourceName, ".")
 	return fmt.Sprintf(`
@@ -98,3 +229,37 @@ func testAccPermissionScheme_description(resourceName, name, description string)
 	}
 	`, splits[0], splits[1], name, description)
 }
+
+func testAccPermissionScheme_permissions(resourceName, name, permission string) string {
+	splits := strings.Split(resourceName, ".")
+	return fmt.Sprintf(`
+	resource %[1]q %[2]q {
+		name = %[3]q
+		permissions = [
+			{
+				holder = {
+					type = "anyone"
+				}
+				permission = %[4]q
+			},
+			{
+				holder = {
+					type      = "projectRole"
+					parameter = "10002"
+				}
+				permission = "ADMINISTER_PROJECTS"
+			},
+		]
+	}
+	`, splits[0], splits[1], name, permission)
+}
+
+func testAccPermissionScheme_deletionProtection(resourceName, name string, deletionProtection bool) string {
+	splits := strings.Split(resourceName, ".")
+	return fmt.Sprintf(`
+	resource %[1]q %[2]q {
+		name                = %[3]q
+		deletion_protection = %[4]t
+	}
+	`, splits[0], splits[1], name, deletionProtection)
+}
@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"strconv"
 
-	jira "github.com/ctreminiom/go-atlassian/jira/v3"
 	"github.com/ctreminiom/go-atlassian/pkg/infra/models"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -29,6 +28,8 @@ type (
 		Name        types.String `tfsdk:"name"`
 		Description types.String `tfsdk:"description"`
 		Self        types.String `tfsdk:"self"`
+
+		Site types.String `tfsdk:"site"`
 	}
 )
 
@@ -85,6 +86,7 @@ func (*jiraProjectCategoryResource) Schema(_ context.Context, _ resource.SchemaR
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"site": resourceSiteAttribute(),
 		},
 	}
 }
@@ -95,20 +97,20 @@ func (r *jiraProjectCategoryResource) Configure(ctx context.Context, req resourc
 		return
 	}
 
-	client, ok := req.ProviderData.(*jira.Client)
+	p, ok := req.ProviderData.(*atlassianProvider)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *jira.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *atlassianProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	r.p.jira = client
+	r.p = *p
 }
 
-func (*jiraProjectCategoryResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+func (r *jiraProjectCategoryResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	r.p.importState(ctx, req, resp, importAttribute{path.Root("id"), true})
 }
 
 func (r *jiraProjectCategoryResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -119,22 +121,19 @@ func (r *jiraProjectCategoryResource) Create(ctx context.Context, req resource.C
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	ctx = withSite(ctx, plan.Site)
 	tflog.Debug(ctx, "Loaded project category plan", map[string]interface{}{
 		"createPlan": fmt.Sprintf("%+v", plan),
 	})
 
 	createPayload := models.ProjectCategoryPayloadScheme{
-		Name:        plan.Name.ValueString(),
-		Description: plan.Description.ValueString(),
+		Name:        r.p.prefixedName(plan.Name.ValueString()),
+		Description: r.p.suffixedDescription(plan.Description.ValueString()),
 	}
 
 	projectCategory, res, err := r.p.jira.Project.Category.Create(ctx, &createPayload)
 	if err != nil {
-		var resBody string
-		if res != nil {
-			resBody = res.Bytes.String()
-		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create project category, got error: %s\n%s", err, resBody))
+		resp.Diagnostics.Append(clientErrorDiagnostics("create project category", res, err)...)
 		return
 	}
 	tflog.Debug(ctx, "Created project category")
@@ -156,6 +155,7 @@ func (r *jiraProjectCategoryResource) Read(ctx context.Context, req resource.Rea
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	ctx = withSite(ctx, state.Site)
 	tflog.Debug(ctx, "Loaded project category from state", map[string]interface{}{
 		"readState": fmt.Sprintf("%+v", state),
 	})
@@ -164,16 +164,17 @@ func (r *jiraProjectCategoryResource) Read(ctx context.Context, req resource.Rea
 
 	projectCategory, res, err := r.p.jira.Project.Category.Get(ctx, projectCategoryId)
 	if err != nil {
-		var resBody string
-		if res != nil {
-			resBody = res.Bytes.String()
+		if isNotFound(res) {
+			removeNotFoundResource(ctx, resp, "project category")
+			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get project category, got error: %s\n%s", err, resBody))
+		resp.Diagnostics.Append(clientErrorDiagnostics("get project category", res, err)...)
+		return
 	}
 	tflog.Debug(ctx, "Retrieved project category from API state")
 
-	state.Name = types.StringValue(projectCategory.Name)
-	state.Description = types.StringValue(projectCategory.Description)
+	state.Name = types.StringValue(r.p.unprefixedName(projectCategory.Name))
+	state.Description = types.StringValue(r.p.unsuffixedDescription(projectCategory.Description))
 	state.Self = types.StringValue(projectCategory.Self)
 
 	tflog.Debug(ctx, "Storing project category into the state", map[string]interface{}{
@@ -190,6 +191,7 @@ func (r *jiraProjectCategoryResource) Update(ctx context.Context, req resource.U
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	ctx = withSite(ctx, plan.Site)
 	tflog.Debug(ctx, "Loaded project category plan", map[string]interface{}{
 		"updatePlan": fmt.Sprintf("%+v", plan),
 	})
@@ -206,17 +208,13 @@ func (r *jiraProjectCategoryResource) Update(ctx context.Context, req resource.U
 	projectCategoryId, _ := strconv.Atoi(state.ID.ValueString())
 
 	updatePayload := models.ProjectCategoryPayloadScheme{
-		Name:        plan.Name.ValueString(),
-		Description: plan.Description.ValueString(),
+		Name:        r.p.prefixedName(plan.Name.ValueString()),
+		Description: r.p.suffixedDescription(plan.Description.ValueString()),
 	}
 
 	_, res, err := r.p.jira.Project.Category.Update(ctx, projectCategoryId, &updatePayload)
 	if err != nil {
-		var resBody string
-		if res != nil {
-			resBody = res.Bytes.String()
-		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update project category, got error: %s\n%s", err, resBody))
+		resp.Diagnostics.Append(clientErrorDiagnostics("update project category", res, err)...)
 		return
 	}
 	tflog.Debug(ctx, "Updated project category in API state")
@@ -234,16 +232,13 @@ func (r *jiraProjectCategoryResource) Delete(ctx context.Context, req resource.D
 		return
 	}
 	tflog.Debug(ctx, "Loaded project category from state")
+	ctx = withSite(ctx, state.Site)
 
 	projectCategoryId, _ := strconv.Atoi(state.ID.ValueString())
 
 	res, err := r.p.jira.Project.Category.Delete(ctx, projectCategoryId)
 	if err != nil {
-		var resBody string
-		if res != nil {
-			resBody = res.Bytes.String()
-		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete project category, got error: %s\n%s", err, resBody))
+		resp.Diagnostics.Append(clientErrorDiagnostics("delete project category", res, err)...)
 		return
 	}
 	tflog.Debug(ctx, "Deleted project category from API state")
@@ -4,8 +4,8 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"strings"
 
-	jira "github.com/ctreminiom/go-atlassian/jira/v3"
 	"github.com/ctreminiom/go-atlassian/pkg/infra/models"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -29,6 +29,10 @@ type (
 		Name        types.String                `tfsdk:"name"`
 		Description types.String                `tfsdk:"description"`
 		Screens     *jiraScreenSchemeTypesModel `tfsdk:"screens"`
+
+		DeletionProtection types.Bool `tfsdk:"deletion_protection"`
+
+		Site types.String `tfsdk:"site"`
 	}
 
 	jiraScreenSchemeTypesModel struct {
@@ -42,6 +46,7 @@ type (
 var (
 	_ resource.Resource                = (*jiraScreenSchemeResource)(nil)
 	_ resource.ResourceWithImportState = (*jiraScreenSchemeResource)(nil)
+	_ resource.ResourceWithModifyPlan  = (*jiraScreenSchemeResource)(nil)
 )
 
 func NewJiraScreenSchemeResource() resource.Resource {
@@ -117,6 +122,8 @@ func (*jiraScreenSchemeResource) Schema(_ context.Context, _ resource.SchemaRequ
 					},
 				},
 			},
+			"deletion_protection": deletionProtectionAttribute("screen scheme"),
+			"site":                resourceSiteAttribute(),
 		},
 	}
 }
@@ -127,20 +134,47 @@ func (r *jiraScreenSchemeResource) Configure(ctx context.Context, req resource.C
 		return
 	}
 
-	client, ok := req.ProviderData.(*jira.Client)
+	p, ok := req.ProviderData.(*atlassianProvider)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *jira.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *atlassianProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	r.p.jira = client
+	r.p = *p
 }
 
-func (*jiraScreenSchemeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+func (r *jiraScreenSchemeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	r.p.importState(ctx, req, resp, importAttribute{path.Root("id"), true})
+}
+
+func (r *jiraScreenSchemeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Replacing the screen scheme deletes it first
+	resp.Diagnostics.Append(replacePlanDiagnostics(ctx, "screen scheme", req, resp)...)
+
+	// Otherwise, only the plans to destroy the screen scheme are checked
+	if !req.Plan.Raw.IsNull() || req.State.Raw.IsNull() || r.p.jira == nil {
+		return
+	}
+
+	var state jiraScreenSchemeResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx = withSite(ctx, state.Site)
+
+	// Screen schemes are used by issue type screen schemes, not by projects
+	usage := func(ctx context.Context) (string, error) {
+		issueTypeScreenSchemes, _, err := r.issueTypeScreenSchemes(ctx, state.ID.ValueString())
+		if err != nil || len(issueTypeScreenSchemes) == 0 {
+			return "", err
+		}
+		return "the issue type screen schemes: " + strings.Join(issueTypeScreenSchemes, ", "), nil
+	}
+	resp.Diagnostics.Append(destroyPlanDiagnostics(ctx, "screen scheme", state.Name.ValueString(), state.DeletionProtection.ValueBool(), false, usage)...)
 }
 
 func (r *jiraScreenSchemeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -151,6 +185,7 @@ func (r *jiraScreenSchemeResource) Create(ctx context.Context, req resource.Crea
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	ctx = withSite(ctx, plan.Site)
 	tflog.Debug(ctx, "Loaded screen scheme plan", map[string]interface{}{
 		"createPlan": fmt.Sprintf("%+v", plan),
 	})
@@ -162,17 +197,13 @@ func (r *jiraScreenSchemeResource) Create(ctx context.Context, req resource.Crea
 			View:    int(plan.Screens.View.ValueInt64()),
 			Edit:    int(plan.Screens.Edit.ValueInt64()),
 		},
-		Name:        plan.Name.ValueString(),
-		Description: plan.Description.ValueString(),
+		Name:        r.p.prefixedName(plan.Name.ValueString()),
+		Description: r.p.suffixedDescription(plan.Description.ValueString()),
 	}
 
 	screenScheme, res, err := r.p.jira.Screen.Scheme.Create(ctx, &createRequestPayload)
 	if err != nil {
-		var resBody string
-		if res != nil {
-			resBody = res.Bytes.String()
-		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create screen scheme, got error: %s\n%s", err, resBody))
+		resp.Diagnostics.Append(clientErrorDiagnostics("create screen scheme", res, err)...)
 		return
 	}
 	tflog.Debug(ctx, "Created screen scheme")
@@ -193,6 +224,7 @@ func (r *jiraScreenSchemeResource) Read(ctx context.Context, req resource.ReadRe
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	ctx = withSite(ctx, state.Site)
 	tflog.Debug(ctx, "Loaded screen scheme from state", map[string]interface{}{
 		"readState": fmt.Sprintf("%+v", state),
 	})
@@ -203,17 +235,21 @@ func (r *jiraScreenSchemeResource) Read(ctx context.Context, req resource.ReadRe
 	}
 	resScreenScheme, res, err := r.p.jira.Screen.Scheme.Gets(ctx, options, 0, 1)
 	if err != nil {
-		var resBody string
-		if res != nil {
-			resBody = res.Bytes.String()
+		if isNotFound(res) {
+			removeNotFoundResource(ctx, resp, "screen scheme")
+			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get screen scheme, got error: %s\n%s", err, resBody))
+		resp.Diagnostics.Append(clientErrorDiagnostics("get screen scheme", res, err)...)
+		return
+	}
+	if len(resScreenScheme.Values) == 0 {
+		removeNotFoundResource(ctx, resp, "screen scheme")
 		return
 	}
 	tflog.Debug(ctx, "Retrieved screen scheme from API state")
 
-	state.Name = types.StringValue(resScreenScheme.Values[0].Name)
-	state.Description = types.StringValue(resScreenScheme.Values[0].Description)
+	state.Name = types.StringValue(r.p.unprefixedName(resScreenScheme.Values[0].Name))
+	state.Description = types.StringValue(r.p.unsuffixedDescription(resScreenScheme.Values[0].Description))
 	state.Screens = &jiraScreenSchemeTypesModel{
 		Create:  types.Int64Value(int64(resScreenScheme.Values[0].Screens.Create)),
 		Default: types.Int64Value(int64(resScreenScheme.Values[0].Screens.Default)),
@@ -234,6 +270,7 @@ func (r *jiraScreenSchemeResource) Update(ctx context.Context, req resource.Upda
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	ctx = withSite(ctx, plan.Site)
 	tflog.Debug(ctx, "Loaded screen scheme plan", map[string]interface{}{
 		"updatePlan": fmt.Sprintf("%+v", plan),
 	})
@@ -248,8 +285,8 @@ func (r *jiraScreenSchemeResource) Update(ctx context.Context, req resource.Upda
 	})
 
 	updateRequestPayload := models.ScreenSchemePayloadScheme{
-		Name:        plan.Name.ValueString(),
-		Description: plan.Description.ValueString(),
+		Name:        r.p.prefixedName(plan.Name.ValueString()),
+		Description: r.p.suffixedDescription(plan.Description.ValueString()),
 		Screens: &models.ScreenTypesScheme{
 			Create:  int(plan.Screens.Create.ValueInt64()),
 			Default: int(plan.Screens.Default.ValueInt64()),
@@ -260,11 +297,7 @@ func (r *jiraScreenSchemeResource) Update(ctx context.Context, req resource.Upda
 
 	res, err := r.p.jira.Screen.Scheme.Update(ctx, state.ID.ValueString(), &updateRequestPayload)
 	if err != nil {
-		var resBody string
-		if res != nil {
-			resBody = res.Bytes.String()
-		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update screen scheme, got error: %s\n%s", err, resBody))
+		resp.Diagnostics.Append(clientErrorDiagnostics("update screen scheme", res, err)...)
 	}
 	tflog.Debug(ctx, "Updated screen scheme in API state")
 
@@ -283,17 +316,71 @@ func (r *jiraScreenSchemeResource) Delete(ctx context.Context, req resource.Dele
 		return
 	}
 	tflog.Debug(ctx, "Loaded screen scheme from state")
+	ctx = withSite(ctx, state.Site)
 
-	res, err := r.p.jira.Screen.Scheme.Delete(ctx, state.ID.ValueString())
+	if state.DeletionProtection.ValueBool() {
+		resp.Diagnostics.Append(deletionProtectionDiagnostics("screen scheme", state.Name.ValueString())...)
+		return
+	}
+
+	issueTypeScreenSchemes, res, err := r.issueTypeScreenSchemes(ctx, state.ID.ValueString())
 	if err != nil {
-		var resBody string
-		if res != nil {
-			resBody = res.Bytes.String()
-		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete screen scheme, got error: %s\n%s", err, resBody))
+		resp.Diagnostics.Append(clientErrorDiagnostics("get issue type screen schemes using screen scheme", res, err)...)
+		return
+	}
+	if len(issueTypeScreenSchemes) > 0 {
+		resp.Diagnostics.AddError("Scheme In Use",
+			fmt.Sprintf("The screen scheme %q cannot be deleted because it is used by the issue type screen schemes: %s. "+
+				"Map the issue types of the issue type screen schemes to another screen scheme before deleting it.",
+				state.Name.ValueString(), strings.Join(issueTypeScreenSchemes, ", ")))
+		return
+	}
+
+	res, err = r.p.jira.Screen.Scheme.Delete(ctx, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(clientErrorDiagnostics("delete screen scheme", res, err)...)
 		return
 	}
 	tflog.Debug(ctx, "Deleted screen scheme from API state")
 
 	// If a Resource type Delete method is completed without error, the framework will automatically remove the resource.
 }
+
+// issueTypeScreenSchemes returns the names of the issue type screen schemes which map an issue type
+// to the screen scheme with the given ID.
+func (r *jiraScreenSchemeResource) issueTypeScreenSchemes(ctx context.Context, screenSchemeId string) ([]string, *models.ResponseScheme, error) {
+	var ids []int
+	found := map[string]bool{}
+	for startAt, isLast := 0, false; !isLast; startAt += jiraListPageSize {
+		mappings, res, err := r.p.jira.Issue.Type.ScreenScheme.Mapping(ctx, nil, startAt, jiraListPageSize)
+		if err != nil {
+			return nil, res, err
+		}
+		for _, m := range mappings.Values {
+			if m.ScreenSchemeID != screenSchemeId || found[m.IssueTypeScreenSchemeID] {
+				continue
+			}
+			found[m.IssueTypeScreenSchemeID] = true
+			if id, err := strconv.Atoi(m.IssueTypeScreenSchemeID); err == nil {
+				ids = append(ids, id)
+			}
+		}
+		isLast = mappings.IsLast || len(mappings.Values) == 0
+	}
+	if len(ids) == 0 {
+		return nil, nil, nil
+	}
+
+	var names []string
+	for startAt, isLast := 0, false; !isLast; startAt += jiraListPageSize {
+		issueTypeScreenSchemes, res, err := r.p.jira.Issue.Type.ScreenScheme.Gets(ctx, &models.ScreenSchemeParamsScheme{IDs: ids}, startAt, jiraListPageSize)
+		if err != nil {
+			return nil, res, err
+		}
+		for _, v := range issueTypeScreenSchemes.Values {
+			names = append(names, v.Name)
+		}
+		isLast = issueTypeScreenSchemes.IsLast || len(issueTypeScreenSchemes.Values) == 0
+	}
+	return names, nil, nil
+}
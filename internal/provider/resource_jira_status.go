@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 
-	jira "github.com/ctreminiom/go-atlassian/jira/v3"
 	"github.com/ctreminiom/go-atlassian/pkg/infra/models"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -29,6 +28,8 @@ type (
 		StatusCategory types.String          `tfsdk:"status_category"`
 		Description    types.String          `tfsdk:"description"`
 		StatusScope    *jiraStatusScopeModel `tfsdk:"status_scope"`
+
+		Site types.String `tfsdk:"site"`
 	}
 	jiraStatusScopeModel struct {
 		Type types.String `tfsdk:"type"`
@@ -110,6 +111,7 @@ func (*jiraStatusResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 					},
 				},
 			},
+			"site": resourceSiteAttribute(),
 		},
 	}
 }
@@ -120,20 +122,20 @@ func (r *jiraStatusResource) Configure(ctx context.Context, req resource.Configu
 		return
 	}
 
-	client, ok := req.ProviderData.(*jira.Client)
+	p, ok := req.ProviderData.(*atlassianProvider)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *jira.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *atlassianProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	r.p.jira = client
+	r.p = *p
 }
 
-func (*jiraStatusResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+func (r *jiraStatusResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	r.p.importState(ctx, req, resp, importAttribute{path.Root("id"), true})
 }
 
 func (r *jiraStatusResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -144,6 +146,7 @@ func (r *jiraStatusResource) Create(ctx context.Context, req resource.CreateRequ
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	ctx = withSite(ctx, plan.Site)
 	tflog.Debug(ctx, "Loaded status plan", map[string]interface{}{
 		"createPlan": fmt.Sprintf("%+v, %+v", plan, plan.StatusScope),
 	})
@@ -164,14 +167,19 @@ func (r *jiraStatusResource) Create(ctx context.Context, req resource.CreateRequ
 				fmt.Sprintf("A value must be provided if \"status_scope.type\" is: %s", plan.StatusScope.Type.ValueString()))
 			return
 		}
+
+		resp.Diagnostics.Append(validateTeamManagedProject(ctx, r.p.jira, path.Root("status_scope").AtName("id"), plan.StatusScope.Id.ValueString())...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
 	}
 
 	payload := &models.WorkflowStatusPayloadScheme{}
 	payload.Statuses = []*models.WorkflowStatusNodeScheme{
 		{
-			Name:           plan.Name.ValueString(),
+			Name:           r.p.prefixedName(plan.Name.ValueString()),
 			StatusCategory: plan.StatusCategory.ValueString(),
-			Description:    plan.Description.ValueString(),
+			Description:    r.p.suffixedDescription(plan.Description.ValueString()),
 		},
 	}
 	if plan.StatusScope.Id.IsNull() || plan.StatusScope.Id.IsUnknown() || plan.StatusScope.Id.ValueString() == "" {
@@ -190,11 +198,7 @@ func (r *jiraStatusResource) Create(ctx context.Context, req resource.CreateRequ
 
 	status, res, err := r.p.jira.Workflow.Status.Create(ctx, payload)
 	if err != nil {
-		var resBody string
-		if res != nil {
-			resBody = res.Bytes.String()
-		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create status, got error: %s\n%s", err, resBody))
+		resp.Diagnostics.Append(clientErrorDiagnostics("create status", res, err)...)
 		return
 	}
 	tflog.Debug(ctx, "Created status in API state")
@@ -215,25 +219,28 @@ func (r *jiraStatusResource) Read(ctx context.Context, req resource.ReadRequest,
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	ctx = withSite(ctx, state.Site)
 	tflog.Debug(ctx, "Loaded status from state", map[string]interface{}{
 		"readState": fmt.Sprintf("%+v", state),
 	})
 
 	status, res, err := r.p.jira.Workflow.Status.Gets(ctx, []string{state.ID.ValueString()}, []string{})
 	if err != nil {
-		var resBody string
-		if res != nil {
-			resBody = res.Bytes.String()
+		if isNotFound(res) {
+			removeNotFoundResource(ctx, resp, "status")
+			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get status, got error: %s\n%s", err, resBody))
+		resp.Diagnostics.Append(clientErrorDiagnostics("get status", res, err)...)
 		return
 	}
-	tflog.Debug(ctx, "Retrieved status from API state", map[string]interface{}{
-		"status": fmt.Sprintf("%v", &status[0].Scope.Type),
-	})
+	if len(status) == 0 {
+		removeNotFoundResource(ctx, resp, "status")
+		return
+	}
+	tflog.Debug(ctx, "Retrieved status from API state")
 
-	state.Name = types.StringValue(status[0].Name)
-	state.Description = types.StringValue(status[0].Description)
+	state.Name = types.StringValue(r.p.unprefixedName(status[0].Name))
+	state.Description = types.StringValue(r.p.unsuffixedDescription(status[0].Description))
 	state.StatusCategory = types.StringValue(status[0].StatusCategory)
 	state.StatusScope = &jiraStatusScopeModel{
 		Type: types.StringValue(status[0].Scope.Type),
@@ -258,6 +265,7 @@ func (r *jiraStatusResource) Update(ctx context.Context, req resource.UpdateRequ
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	ctx = withSite(ctx, plan.Site)
 	tflog.Debug(ctx, "Loaded status plan", map[string]interface{}{
 		"updatePlan": fmt.Sprintf("%+v", plan),
 	})
@@ -275,8 +283,8 @@ func (r *jiraStatusResource) Update(ctx context.Context, req resource.UpdateRequ
 		Statuses: []*models.WorkflowStatusNodeScheme{
 			{
 				ID:             state.ID.ValueString(),
-				Name:           plan.Name.ValueString(),
-				Description:    plan.Description.ValueString(),
+				Name:           r.p.prefixedName(plan.Name.ValueString()),
+				Description:    r.p.suffixedDescription(plan.Description.ValueString()),
 				StatusCategory: plan.StatusCategory.ValueString(),
 			},
 		},
@@ -284,11 +292,7 @@ func (r *jiraStatusResource) Update(ctx context.Context, req resource.UpdateRequ
 
 	res, err := r.p.jira.Workflow.Status.Update(ctx, payload)
 	if err != nil {
-		var resBody string
-		if res != nil {
-			resBody = res.Bytes.String()
-		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update status, got error: %s\n%s", err, resBody))
+		resp.Diagnostics.Append(clientErrorDiagnostics("update status", res, err)...)
 		return
 	}
 	tflog.Debug(ctx, "Updated status in API state")
@@ -306,14 +310,11 @@ func (r *jiraStatusResource) Delete(ctx context.Context, req resource.DeleteRequ
 		return
 	}
 	tflog.Debug(ctx, "Loaded status from state")
+	ctx = withSite(ctx, state.Site)
 
 	res, err := r.p.jira.Workflow.Status.Delete(ctx, []string{state.ID.ValueString()})
 	if err != nil {
-		var resBody string
-		if res != nil {
-			resBody = res.Bytes.String()
-		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete status, got error: %s\n%s", err, resBody))
+		resp.Diagnostics.Append(clientErrorDiagnostics("delete status", res, err)...)
 		return
 	}
 	tflog.Debug(ctx, "Deleted status from API state")
@@ -145,6 +145,21 @@ func TestAccJiraStatus_StatusScopeTypeErrors(t *testing.T) {
 	})
 }
 
+func TestAccJiraStatus_StatusScopeIdCompanyManaged(t *testing.T) {
+	randomName := acctest.RandomWithPrefix("tf-test-jira-status")
+	resourceName = "atlassian_jira_status.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccStatusConfig_statusscopeid(resourceName, randomName, "10000"), // company-managed project
+				ExpectError: regexp.MustCompile(`can only be used with team-managed projects`),
+			},
+		},
+	})
+}
+
 func TestAccJiraStatus_StatusScopeId(t *testing.T) {
 	randomName := acctest.RandomWithPrefix("tf-test-jira-status")
 	resourceName = "atlassian_jira_status.test"
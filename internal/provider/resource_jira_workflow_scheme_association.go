@@ -0,0 +1,209 @@
+package atlassian
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+type (
+	jiraWorkflowSchemeAssociationResource struct {
+		p atlassianProvider
+	}
+
+	jiraWorkflowSchemeAssociationResourceModel struct {
+		ID               types.String `tfsdk:"id"`
+		ProjectID        types.String `tfsdk:"project_id"`
+		WorkflowSchemeID types.String `tfsdk:"workflow_scheme_id"`
+
+		Site types.String `tfsdk:"site"`
+	}
+)
+
+var (
+	_ resource.Resource                = (*jiraWorkflowSchemeAssociationResource)(nil)
+	_ resource.ResourceWithImportState = (*jiraWorkflowSchemeAssociationResource)(nil)
+)
+
+func NewJiraWorkflowSchemeAssociationResource() resource.Resource {
+	return &jiraWorkflowSchemeAssociationResource{}
+}
+
+func (*jiraWorkflowSchemeAssociationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_jira_workflow_scheme_association"
+}
+
+func (*jiraWorkflowSchemeAssociationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version:             1,
+		MarkdownDescription: "Jira Workflow Scheme Association Resource",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the workflow scheme association. " +
+					"It is computed using `project_id` and `workflow_scheme_id` separated by a comma (`,`).",
+				Computed: true,
+			},
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "(Forces new resource) The ID of the project.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"workflow_scheme_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the workflow scheme to assign to the project. " +
+					"On destroy, the default workflow scheme is assigned to the project.",
+				Required: true,
+			},
+			"site": resourceSiteAttribute(),
+		},
+	}
+}
+
+func (r *jiraWorkflowSchemeAssociationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	p, ok := req.ProviderData.(*atlassianProvider)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *atlassianProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.p = *p
+}
+
+func (r *jiraWorkflowSchemeAssociationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	r.p.importState(ctx, req, resp,
+		importAttribute{path.Root("project_id"), true},
+		importAttribute{path.Root("workflow_scheme_id"), true},
+	)
+}
+
+func (r *jiraWorkflowSchemeAssociationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Creating workflow scheme association resource")
+
+	var plan jiraWorkflowSchemeAssociationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx = withSite(ctx, plan.Site)
+	tflog.Debug(ctx, "Loaded workflow scheme association plan", map[string]interface{}{
+		"createPlan": fmt.Sprintf("%+v", plan),
+	})
+
+	res, err := r.p.jira.Workflow.Scheme.Assign(ctx, plan.WorkflowSchemeID.ValueString(), plan.ProjectID.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(clientErrorDiagnostics("assign workflow scheme to project", res, err)...)
+		return
+	}
+	tflog.Debug(ctx, "Created workflow scheme association")
+
+	plan.ID = types.StringValue(compositeID(plan.ProjectID.ValueString(), plan.WorkflowSchemeID.ValueString()))
+
+	tflog.Debug(ctx, "Storing workflow scheme association into the state", map[string]interface{}{
+		"createNewState": fmt.Sprintf("%+v", plan),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *jiraWorkflowSchemeAssociationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Reading workflow scheme association resource")
+
+	var state jiraWorkflowSchemeAssociationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx = withSite(ctx, state.Site)
+	tflog.Debug(ctx, "Loaded workflow scheme association from state", map[string]interface{}{
+		"readState": fmt.Sprintf("%+v", state),
+	})
+
+	projectId, _ := strconv.Atoi(state.ProjectID.ValueString())
+	projectWorkflowSchemes, res, err := r.p.jira.Workflow.Scheme.Associations(ctx, []int{projectId})
+	if err != nil {
+		if isNotFound(res) {
+			removeNotFoundResource(ctx, resp, "workflow scheme association")
+			return
+		}
+		resp.Diagnostics.Append(clientErrorDiagnostics("get project workflow scheme", res, err)...)
+		return
+	}
+	tflog.Debug(ctx, "Retrieved workflow scheme association from API state")
+
+	// The default workflow scheme is returned without an ID
+	state.WorkflowSchemeID = types.StringValue("")
+	for _, v := range projectWorkflowSchemes.Values {
+		if v.WorkflowScheme != nil && v.WorkflowScheme.ID != 0 {
+			state.WorkflowSchemeID = types.StringValue(strconv.Itoa(v.WorkflowScheme.ID))
+		}
+	}
+	state.ID = types.StringValue(compositeID(state.ProjectID.ValueString(), state.WorkflowSchemeID.ValueString()))
+
+	tflog.Debug(ctx, "Storing workflow scheme association into the state", map[string]interface{}{
+		"readNewState": fmt.Sprintf("%+v", state),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *jiraWorkflowSchemeAssociationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Updating workflow scheme association resource")
+
+	var plan jiraWorkflowSchemeAssociationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx = withSite(ctx, plan.Site)
+	tflog.Debug(ctx, "Loaded workflow scheme association plan", map[string]interface{}{
+		"updatePlan": fmt.Sprintf("%+v", plan),
+	})
+
+	res, err := r.p.jira.Workflow.Scheme.Assign(ctx, plan.WorkflowSchemeID.ValueString(), plan.ProjectID.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(clientErrorDiagnostics("assign workflow scheme to project", res, err)...)
+		return
+	}
+	tflog.Debug(ctx, "Updated workflow scheme association in API state")
+
+	plan.ID = types.StringValue(compositeID(plan.ProjectID.ValueString(), plan.WorkflowSchemeID.ValueString()))
+
+	tflog.Debug(ctx, "Storing workflow scheme association into the state")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *jiraWorkflowSchemeAssociationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Deleting workflow scheme association resource")
+
+	var state jiraWorkflowSchemeAssociationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded workflow scheme association from state")
+	ctx = withSite(ctx, state.Site)
+
+	res, err := assignDefaultProjectScheme(ctx, r.p.jira, "rest/api/3/workflowscheme/project", "workflowSchemeId", state.ProjectID.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(clientErrorDiagnostics("assign default workflow scheme to project", res, err)...)
+		return
+	}
+	tflog.Debug(ctx, "Deleted workflow scheme association from API state")
+
+	// If a Resource type Delete method is completed without error, the framework will automatically remove the resource.
+}
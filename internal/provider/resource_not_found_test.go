@@ -0,0 +1,312 @@
+package atlassian
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	jira "github.com/ctreminiom/go-atlassian/jira/v3"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+const (
+	testNotFoundBody  = `{"errorMessages": ["The resource does not exist."], "errors": {}}`
+	testEmptyPageBody = `{"startAt": 0, "maxResults": 50, "total": 0, "isLast": true, "values": []}`
+)
+
+// TestResourceRead_NotFound checks that every resource is removed from the state when it was
+// deleted outside Terraform, using a fake Atlassian server.
+func TestResourceRead_NotFound(t *testing.T) {
+	tests := map[string]struct {
+		resource   func() resource.Resource
+		attributes map[string]string
+		status     int
+		body       string
+	}{
+		"avatar": {
+			resource:   NewJiraAvatarResource,
+			attributes: map[string]string{"id": "10100", "type": "project", "owner_id": "10000"},
+			status:     http.StatusNotFound,
+			body:       testNotFoundBody,
+		},
+		"group": {
+			resource:   NewJiraGroupResource,
+			attributes: map[string]string{"name": "test-group"},
+			status:     http.StatusOK,
+			body:       testEmptyPageBody,
+		},
+		"group/404": {
+			resource:   NewJiraGroupResource,
+			attributes: map[string]string{"name": "test-group"},
+			status:     http.StatusNotFound,
+			body:       testNotFoundBody,
+		},
+		"group_user": {
+			resource:   NewJiraGroupUserResource,
+			attributes: map[string]string{"group_name": "test-group", "account_id": "5b10ac8d82e05b22cc7d4ef5"},
+			status:     http.StatusOK,
+			body:       testEmptyPageBody,
+		},
+		"group_user/404": {
+			resource:   NewJiraGroupUserResource,
+			attributes: map[string]string{"group_name": "test-group", "account_id": "5b10ac8d82e05b22cc7d4ef5"},
+			status:     http.StatusNotFound,
+			body:       testNotFoundBody,
+		},
+		"issue_field_configuration": {
+			resource:   NewJiraIssueFieldConfigurationResource,
+			attributes: map[string]string{"id": "10000"},
+			status:     http.StatusOK,
+			body:       testEmptyPageBody,
+		},
+		"issue_field_configuration_item": {
+			resource:   NewJiraIssueFieldConfigurationItemResource,
+			attributes: map[string]string{"issue_field_configuration": "10000", "item.id": "summary"},
+			status:     http.StatusOK,
+			body:       testEmptyPageBody,
+		},
+		"issue_field_configuration_item/404": {
+			resource:   NewJiraIssueFieldConfigurationItemResource,
+			attributes: map[string]string{"issue_field_configuration": "10000", "item.id": "summary"},
+			status:     http.StatusNotFound,
+			body:       testNotFoundBody,
+		},
+		"issue_field_configuration_scheme": {
+			resource:   NewJiraIssueFieldConfigurationSchemeResource,
+			attributes: map[string]string{"id": "10000"},
+			status:     http.StatusOK,
+			body:       testEmptyPageBody,
+		},
+		"issue_field_configuration_scheme_association": {
+			resource:   NewJiraIssueFieldConfigurationSchemeAssociationResource,
+			attributes: map[string]string{"project_id": "10000", "field_configuration_scheme_id": "10000"},
+			status:     http.StatusNotFound,
+			body:       testNotFoundBody,
+		},
+		"issue_field_configuration_scheme_mapping": {
+			resource:   NewJiraIssueFieldConfigurationSchemeMappingResource,
+			attributes: map[string]string{"field_configuration_scheme_id": "10000", "field_configuration_id": "10000", "issue_type_id": "10000"},
+			status:     http.StatusOK,
+			body:       testEmptyPageBody,
+		},
+		"issue_field_configuration_scheme_mapping/404": {
+			resource:   NewJiraIssueFieldConfigurationSchemeMappingResource,
+			attributes: map[string]string{"field_configuration_scheme_id": "10000", "field_configuration_id": "10000", "issue_type_id": "10000"},
+			status:     http.StatusNotFound,
+			body:       testNotFoundBody,
+		},
+		"issue_screen": {
+			resource:   NewJiraIssueScreenResource,
+			attributes: map[string]string{"id": "10000"},
+			status:     http.StatusOK,
+			body:       testEmptyPageBody,
+		},
+		"issue_type": {
+			resource:   NewJiraIssueTypeResource,
+			attributes: map[string]string{"id": "10000"},
+			status:     http.StatusNotFound,
+			body:       testNotFoundBody,
+		},
+		"issue_type_scheme": {
+			resource:   NewJiraIssueTypeSchemeResource,
+			attributes: map[string]string{"id": "10000"},
+			status:     http.StatusOK,
+			body:       testEmptyPageBody,
+		},
+		"issue_type_scheme_association": {
+			resource:   NewJiraIssueTypeSchemeAssociationResource,
+			attributes: map[string]string{"project_id": "10000", "issue_type_scheme_id": "10000"},
+			status:     http.StatusNotFound,
+			body:       testNotFoundBody,
+		},
+		"issue_type_screen_scheme": {
+			resource:   NewJiraIssueTypeScreenSchemeResource,
+			attributes: map[string]string{"id": "10000"},
+			status:     http.StatusOK,
+			body:       testEmptyPageBody,
+		},
+		"issue_type_screen_scheme_association": {
+			resource:   NewJiraIssueTypeScreenSchemeAssociationResource,
+			attributes: map[string]string{"project_id": "10000", "issue_type_screen_scheme_id": "10000"},
+			status:     http.StatusNotFound,
+			body:       testNotFoundBody,
+		},
+		"notification_scheme_association": {
+			resource:   NewJiraNotificationSchemeAssociationResource,
+			attributes: map[string]string{"project_id": "10000", "notification_scheme_id": "10000"},
+			status:     http.StatusNotFound,
+			body:       testNotFoundBody,
+		},
+		"permission_grant": {
+			resource:   NewJiraPermissionGrantResource,
+			attributes: map[string]string{"id": "10000", "permission_scheme_id": "10000"},
+			status:     http.StatusNotFound,
+			body:       testNotFoundBody,
+		},
+		"permission_scheme": {
+			resource:   NewJiraPermissionSchemeResource,
+			attributes: map[string]string{"id": "10000"},
+			status:     http.StatusNotFound,
+			body:       testNotFoundBody,
+		},
+		"permission_scheme_association": {
+			resource:   NewJiraPermissionSchemeAssociationResource,
+			attributes: map[string]string{"project_id": "10000", "permission_scheme_id": "10000"},
+			status:     http.StatusNotFound,
+			body:       testNotFoundBody,
+		},
+		"project_category": {
+			resource:   NewJiraProjectCategoryResource,
+			attributes: map[string]string{"id": "10000"},
+			status:     http.StatusNotFound,
+			body:       testNotFoundBody,
+		},
+		"screen_scheme": {
+			resource:   NewJiraScreenSchemeResource,
+			attributes: map[string]string{"id": "10000"},
+			status:     http.StatusOK,
+			body:       testEmptyPageBody,
+		},
+		"status": {
+			resource:   NewJiraStatusResource,
+			attributes: map[string]string{"id": "10000"},
+			status:     http.StatusOK,
+			body:       `[]`,
+		},
+		"workflow_scheme_association": {
+			resource:   NewJiraWorkflowSchemeAssociationResource,
+			attributes: map[string]string{"project_id": "10000", "workflow_scheme_id": "10000"},
+			status:     http.StatusNotFound,
+			body:       testNotFoundBody,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(tt.body))
+			}))
+			defer srv.Close()
+
+			client, err := jira.New(srv.Client(), srv.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			r := tt.resource()
+			configureResp := &resource.ConfigureResponse{}
+			r.(resource.ResourceWithConfigure).Configure(ctx, resource.ConfigureRequest{ProviderData: &atlassianProvider{jira: client}}, configureResp)
+			if configureResp.Diagnostics.HasError() {
+				t.Fatalf("unexpected configure diagnostics: %v", configureResp.Diagnostics)
+			}
+
+			state := testResourceState(t, r, tt.attributes)
+			resp := &resource.ReadResponse{State: state}
+			r.Read(ctx, resource.ReadRequest{State: state}, resp)
+
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected read diagnostics: %v", resp.Diagnostics)
+			}
+			if !resp.State.Raw.IsNull() {
+				t.Fatalf("expected resource to be removed from state, got: %s", resp.State.Raw)
+			}
+		})
+	}
+}
+
+// TestResourceRead_LaterPage checks that the resources read from paginated lists are found past
+// the first page, e.g. after they are imported.
+func TestResourceRead_LaterPage(t *testing.T) {
+	tests := map[string]struct {
+		resource   func() resource.Resource
+		attributes map[string]string
+		firstPage  string
+		lastPage   string
+	}{
+		"issue_field_configuration_item": {
+			resource:   NewJiraIssueFieldConfigurationItemResource,
+			attributes: map[string]string{"issue_field_configuration": "10000", "item.id": "summary"},
+			firstPage:  `{"isLast": false, "values": [{"id": "description"}]}`,
+			lastPage:   `{"isLast": true, "values": [{"id": "summary", "isRequired": true, "renderer": "text-renderer"}]}`,
+		},
+		"issue_field_configuration_scheme_mapping": {
+			resource:   NewJiraIssueFieldConfigurationSchemeMappingResource,
+			attributes: map[string]string{"field_configuration_scheme_id": "10000", "field_configuration_id": "10001", "issue_type_id": "default"},
+			firstPage:  `{"isLast": false, "values": [{"fieldConfigurationSchemeId": "10000", "fieldConfigurationId": "10001", "issueTypeId": "10002"}]}`,
+			lastPage:   `{"isLast": true, "values": [{"fieldConfigurationSchemeId": "10000", "fieldConfigurationId": "10001", "issueTypeId": "default"}]}`,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				if r.URL.Query().Get("startAt") == "0" {
+					_, _ = w.Write([]byte(tt.firstPage))
+					return
+				}
+				_, _ = w.Write([]byte(tt.lastPage))
+			}))
+			defer srv.Close()
+
+			client, err := jira.New(srv.Client(), srv.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			r := tt.resource()
+			r.(resource.ResourceWithConfigure).Configure(ctx, resource.ConfigureRequest{ProviderData: &atlassianProvider{jira: client}}, &resource.ConfigureResponse{})
+
+			state := testResourceState(t, r, tt.attributes)
+			resp := &resource.ReadResponse{State: state}
+			r.Read(ctx, resource.ReadRequest{State: state}, resp)
+
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected read diagnostics: %v", resp.Diagnostics)
+			}
+			if resp.State.Raw.IsNull() {
+				t.Fatal("expected resource found on the last page to stay in state")
+			}
+		})
+	}
+}
+
+// testResourceState returns a state of the resource with the given string attributes, where
+// nested attributes are separated by a dot, e.g. "item.id".
+func testResourceState(t *testing.T, r resource.Resource, attributes map[string]string) tfsdk.State {
+	t.Helper()
+	ctx := context.Background()
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	if schemaResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected schema diagnostics: %v", schemaResp.Diagnostics)
+	}
+
+	state := tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+	for name, value := range attributes {
+		p := path.Empty()
+		for _, step := range strings.Split(name, ".") {
+			p = p.AtName(step)
+		}
+		if diags := state.SetAttribute(ctx, p, value); diags.HasError() {
+			t.Fatalf("unable to set attribute %q: %v", name, diags)
+		}
+	}
+
+	return state
+}
@@ -0,0 +1,265 @@
+package atlassian
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	jira "github.com/ctreminiom/go-atlassian/jira/v3"
+	"github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// jiraSchemeProjectsFunc returns the projects using a scheme.
+type jiraSchemeProjectsFunc func(ctx context.Context, client *jira.Client, schemeId string) ([]*models.ProjectScheme, *models.ResponseScheme, error)
+
+// jiraProjectSchemeType is a type of scheme assigned to projects, e.g. permission schemes.
+type jiraProjectSchemeType struct {
+	// object is the name of the type of scheme, e.g. "permission scheme".
+	object string
+	// projects returns the projects using a scheme.
+	projects jiraSchemeProjectsFunc
+	// assignDefault assigns the default scheme to a project.
+	assignDefault func(ctx context.Context, client *jira.Client, projectId string) (*models.ResponseScheme, error)
+}
+
+var (
+	jiraPermissionSchemeType = jiraProjectSchemeType{
+		object:   "permission scheme",
+		projects: jiraPermissionSchemeProjects,
+		assignDefault: func(ctx context.Context, client *jira.Client, projectId string) (*models.ResponseScheme, error) {
+			defaultSchemeId, _ := strconv.Atoi(jiraDefaultPermissionSchemeID)
+			_, res, err := client.Project.Permission.Assign(ctx, projectId, defaultSchemeId)
+			return res, err
+		},
+	}
+	jiraIssueTypeSchemeType = jiraProjectSchemeType{
+		object:   "issue type scheme",
+		projects: jiraIssueTypeSchemeProjects,
+		assignDefault: func(ctx context.Context, client *jira.Client, projectId string) (*models.ResponseScheme, error) {
+			return client.Issue.Type.Scheme.Assign(ctx, jiraDefaultIssueTypeSchemeID, projectId)
+		},
+	}
+	jiraIssueTypeScreenSchemeType = jiraProjectSchemeType{
+		object:   "issue type screen scheme",
+		projects: jiraIssueTypeScreenSchemeProjects,
+		assignDefault: func(ctx context.Context, client *jira.Client, projectId string) (*models.ResponseScheme, error) {
+			return client.Issue.Type.ScreenScheme.Assign(ctx, jiraDefaultIssueTypeScreenSchemeID, projectId)
+		},
+	}
+	jiraIssueFieldConfigurationSchemeType = jiraProjectSchemeType{
+		object:   "issue field configuration scheme",
+		projects: jiraIssueFieldConfigurationSchemeProjects,
+		assignDefault: func(ctx context.Context, client *jira.Client, projectId string) (*models.ResponseScheme, error) {
+			return assignDefaultProjectScheme(ctx, client, "rest/api/3/fieldconfigurationscheme/project", "fieldConfigurationSchemeId", projectId)
+		},
+	}
+)
+
+// usage returns the usages of the scheme with the given ID by projects.
+func (t jiraProjectSchemeType) usage(client *jira.Client, schemeId string) schemeUsageFunc {
+	return func(ctx context.Context) (string, error) {
+		projects, _, err := t.projects(ctx, client, schemeId)
+		if err != nil || len(projects) == 0 {
+			return "", err
+		}
+		return "the projects: " + jiraProjectKeys(projects), nil
+	}
+}
+
+// detachProjects prepares the deletion of the scheme with the given ID. It fails when the scheme is
+// used by projects, unless forceDetach is set, in which case the projects are assigned the default
+// scheme instead.
+func (t jiraProjectSchemeType) detachProjects(ctx context.Context, client *jira.Client, schemeId, name string, forceDetach bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	projects, res, err := t.projects(ctx, client, schemeId)
+	if err != nil {
+		diags.Append(clientErrorDiagnostics(fmt.Sprintf("get projects using %s", t.object), res, err)...)
+		return diags
+	}
+	if len(projects) == 0 {
+		return diags
+	}
+
+	if !forceDetach {
+		diags.AddError("Scheme In Use",
+			fmt.Sprintf("The %s %q cannot be deleted because it is used by the projects: %s. "+
+				"Assign the projects to another %s, or set `force_detach` to `true` to assign them to the default %s, before deleting it.",
+				t.object, name, jiraProjectKeys(projects), t.object, t.object))
+		return diags
+	}
+
+	for _, p := range projects {
+		res, err := t.assignDefault(ctx, client, p.ID)
+		if err != nil {
+			diags.Append(clientErrorDiagnostics(fmt.Sprintf("assign default %s to project %s", t.object, p.Key), res, err)...)
+			return diags
+		}
+		tflog.Debug(ctx, fmt.Sprintf("Assigned default %s to project", t.object), map[string]interface{}{
+			"projectKey": p.Key,
+		})
+	}
+
+	return diags
+}
+
+// forceDetachAttribute returns the `force_detach` attribute of the schemes assigned to projects.
+func forceDetachAttribute(object string) schema.BoolAttribute {
+	return schema.BoolAttribute{
+		MarkdownDescription: fmt.Sprintf("Whether to assign the projects using the %s to the default %s before deleting it. "+
+			"Defaults to `false`, in which case deleting the %s fails while projects use it.", object, object, object),
+		Optional: true,
+	}
+}
+
+// jiraProjectSchemePageFunc returns a page of the scheme assignments of the given projects, and
+// the IDs of the projects in the page assigned to the scheme with the given ID.
+type jiraProjectSchemePageFunc func(ctx context.Context, projectIds []int, startAt int) (assigned []string, isLast bool, res *models.ResponseScheme, err error)
+
+// jiraProjects returns every project of the site, in order of project key.
+func jiraProjects(ctx context.Context, client *jira.Client) ([]*models.ProjectScheme, *models.ResponseScheme, error) {
+	var projects []*models.ProjectScheme
+	for startAt, isLast := 0, false; !isLast; startAt += jiraListPageSize {
+		page, res, err := client.Project.Search(ctx, &models.ProjectSearchOptionsScheme{OrderBy: "key"}, startAt, jiraListPageSize)
+		if err != nil {
+			return nil, res, err
+		}
+		projects = append(projects, page.Values...)
+		isLast = page.IsLast || len(page.Values) == 0
+	}
+	return projects, nil, nil
+}
+
+// jiraSchemeProjects returns the projects assigned to a scheme, by requesting the scheme
+// assignments of every project of the site in batches.
+func jiraSchemeProjects(ctx context.Context, client *jira.Client, page jiraProjectSchemePageFunc) ([]*models.ProjectScheme, *models.ResponseScheme, error) {
+	projects, res, err := jiraProjects(ctx, client)
+	if err != nil {
+		return nil, res, err
+	}
+
+	assigned := map[string]bool{}
+	for i := 0; i < len(projects); i += jiraListPageSize {
+		var projectIds []int
+		for _, p := range projects[i:minInt(i+jiraListPageSize, len(projects))] {
+			id, err := strconv.Atoi(p.ID)
+			if err != nil {
+				continue
+			}
+			projectIds = append(projectIds, id)
+		}
+
+		for startAt, isLast := 0, false; !isLast; startAt += jiraListPageSize {
+			var ids []string
+			ids, isLast, res, err = page(ctx, projectIds, startAt)
+			if err != nil {
+				return nil, res, err
+			}
+			for _, id := range ids {
+				assigned[id] = true
+			}
+		}
+	}
+
+	var used []*models.ProjectScheme
+	for _, p := range projects {
+		if assigned[p.ID] {
+			used = append(used, p)
+		}
+	}
+	return used, nil, nil
+}
+
+// jiraPermissionSchemeProjects returns the projects using a permission scheme. The API has no
+// lookup by permission scheme, so the permission scheme of every project is requested, i.e. one
+// request per project of the site.
+func jiraPermissionSchemeProjects(ctx context.Context, client *jira.Client, schemeId string) ([]*models.ProjectScheme, *models.ResponseScheme, error) {
+	projects, res, err := jiraProjects(ctx, client)
+	if err != nil {
+		return nil, res, err
+	}
+
+	var used []*models.ProjectScheme
+	for _, p := range projects {
+		scheme, res, err := client.Project.Permission.Get(ctx, p.ID, nil)
+		if err != nil {
+			return nil, res, err
+		}
+		if strconv.Itoa(scheme.ID) == schemeId {
+			used = append(used, p)
+		}
+	}
+	return used, nil, nil
+}
+
+// jiraIssueTypeSchemeProjects returns the projects using an issue type scheme.
+func jiraIssueTypeSchemeProjects(ctx context.Context, client *jira.Client, schemeId string) ([]*models.ProjectScheme, *models.ResponseScheme, error) {
+	return jiraSchemeProjects(ctx, client, func(ctx context.Context, projectIds []int, startAt int) ([]string, bool, *models.ResponseScheme, error) {
+		page, res, err := client.Issue.Type.Scheme.Projects(ctx, projectIds, startAt, jiraListPageSize)
+		if err != nil {
+			return nil, false, res, err
+		}
+		var assigned []string
+		for _, v := range page.Values {
+			if v.IssueTypeScheme != nil && v.IssueTypeScheme.ID == schemeId {
+				assigned = append(assigned, v.ProjectIds...)
+			}
+		}
+		return assigned, page.IsLast || len(page.Values) == 0, res, nil
+	})
+}
+
+// jiraIssueTypeScreenSchemeProjects returns the projects using an issue type screen scheme.
+func jiraIssueTypeScreenSchemeProjects(ctx context.Context, client *jira.Client, schemeId string) ([]*models.ProjectScheme, *models.ResponseScheme, error) {
+	return jiraSchemeProjects(ctx, client, func(ctx context.Context, projectIds []int, startAt int) ([]string, bool, *models.ResponseScheme, error) {
+		page, res, err := client.Issue.Type.ScreenScheme.Projects(ctx, projectIds, startAt, jiraListPageSize)
+		if err != nil {
+			return nil, false, res, err
+		}
+		var assigned []string
+		for _, v := range page.Values {
+			if v.IssueTypeScreenScheme != nil && v.IssueTypeScreenScheme.ID == schemeId {
+				assigned = append(assigned, v.ProjectIds...)
+			}
+		}
+		return assigned, page.IsLast || len(page.Values) == 0, res, nil
+	})
+}
+
+// jiraIssueFieldConfigurationSchemeProjects returns the projects using an issue field configuration scheme.
+func jiraIssueFieldConfigurationSchemeProjects(ctx context.Context, client *jira.Client, schemeId string) ([]*models.ProjectScheme, *models.ResponseScheme, error) {
+	return jiraSchemeProjects(ctx, client, func(ctx context.Context, projectIds []int, startAt int) ([]string, bool, *models.ResponseScheme, error) {
+		page, res, err := client.Issue.Field.Configuration.Scheme.Project(ctx, projectIds, startAt, jiraListPageSize)
+		if err != nil {
+			return nil, false, res, err
+		}
+		var assigned []string
+		for _, v := range page.Values {
+			// Projects using the default field configuration scheme have no scheme
+			if v.FieldConfigurationScheme != nil && v.FieldConfigurationScheme.ID == schemeId {
+				assigned = append(assigned, v.ProjectIds...)
+			}
+		}
+		return assigned, page.IsLast || len(page.Values) == 0, res, nil
+	})
+}
+
+// jiraProjectKeys returns the keys of the projects as a comma separated list.
+func jiraProjectKeys(projects []*models.ProjectScheme) string {
+	keys := make([]string, 0, len(projects))
+	for _, p := range projects {
+		keys = append(keys, p.Key)
+	}
+	return strings.Join(keys, ", ")
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
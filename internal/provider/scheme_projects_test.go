@@ -0,0 +1,160 @@
+package atlassian
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	jira "github.com/ctreminiom/go-atlassian/jira/v3"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// newTestSchemeProjectsServer returns a fake Atlassian server with the projects TEST1 and TEST2,
+// which use the permission, issue type and issue field configuration schemes 10000 and 10001, and
+// the issue type screen scheme 10000 which uses the screen scheme 10000. The write requests sent to
+// the server are recorded in writes.
+func newTestSchemeProjectsServer(t *testing.T) (client *jira.Client, writes *[]string) {
+	t.Helper()
+	writes = &[]string{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method != http.MethodGet {
+			body, _ := io.ReadAll(r.Body)
+			*writes = append(*writes, strings.TrimSpace(r.Method+" "+r.URL.Path+" "+string(body)))
+			fmt.Fprint(w, `{}`)
+			return
+		}
+		switch r.URL.Path {
+		case "/rest/api/3/project/search":
+			fmt.Fprint(w, `{"isLast": true, "values": [{"id": "10000", "key": "TEST1"}, {"id": "10001", "key": "TEST2"}]}`)
+		case "/rest/api/3/project/10000/permissionscheme":
+			fmt.Fprint(w, `{"id": 10000}`)
+		case "/rest/api/3/project/10001/permissionscheme":
+			fmt.Fprint(w, `{"id": 10001}`)
+		case "/rest/api/3/issuetypescheme/project":
+			fmt.Fprint(w, `{"isLast": true, "values": [{"issueTypeScheme": {"id": "10000"}, "projectIds": ["10000"]}, {"issueTypeScheme": {"id": "10001"}, "projectIds": ["10001"]}]}`)
+		case "/rest/api/3/fieldconfigurationscheme/project":
+			// Projects using the default field configuration scheme have no scheme
+			fmt.Fprint(w, `{"isLast": true, "values": [{"projectIds": ["10000"]}, {"fieldConfigurationScheme": {"id": "10001"}, "projectIds": ["10001"]}]}`)
+		case "/rest/api/3/issuetypescreenscheme/mapping":
+			fmt.Fprint(w, `{"isLast": true, "values": [{"issueTypeScreenSchemeId": "10000", "issueTypeId": "default", "screenSchemeId": "10000"}, {"issueTypeScreenSchemeId": "10000", "issueTypeId": "10001", "screenSchemeId": "10000"}]}`)
+		case "/rest/api/3/issuetypescreenscheme":
+			fmt.Fprint(w, `{"isLast": true, "values": [{"id": "10000", "name": "Test Issue Type Screen Scheme"}]}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, testNotFoundBody)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	client, err := jira.New(srv.Client(), srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return client, writes
+}
+
+func TestJiraSchemeProjects(t *testing.T) {
+	ctx := context.Background()
+	client, _ := newTestSchemeProjectsServer(t)
+
+	tests := map[string]struct {
+		projects jiraSchemeProjectsFunc
+		schemeId string
+		want     string
+	}{
+		"permission scheme":                       {projects: jiraPermissionSchemeProjects, schemeId: "10001", want: "TEST2"},
+		"issue type scheme":                       {projects: jiraIssueTypeSchemeProjects, schemeId: "10000", want: "TEST1"},
+		"issue field configuration scheme":        {projects: jiraIssueFieldConfigurationSchemeProjects, schemeId: "10001", want: "TEST2"},
+		"issue field configuration scheme/unused": {projects: jiraIssueFieldConfigurationSchemeProjects, schemeId: "10000", want: ""},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			projects, _, err := tt.projects(ctx, client, tt.schemeId)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := jiraProjectKeys(projects); got != tt.want {
+				t.Errorf("expected projects %q, got: %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestJiraProjectSchemeType_DetachProjects(t *testing.T) {
+	ctx := context.Background()
+
+	tests := map[string]struct {
+		schemeType  jiraProjectSchemeType
+		schemeId    string
+		forceDetach bool
+		wantError   bool
+		wantWrites  []string
+	}{
+		"unused": {
+			schemeType: jiraIssueFieldConfigurationSchemeType,
+			schemeId:   "10000",
+		},
+		"in use": {
+			schemeType: jiraPermissionSchemeType,
+			schemeId:   "10000",
+			wantError:  true,
+		},
+		"permission scheme": {
+			schemeType:  jiraPermissionSchemeType,
+			schemeId:    "10000",
+			forceDetach: true,
+			wantWrites:  []string{`PUT /rest/api/3/project/10000/permissionscheme {"id":0}`},
+		},
+		"issue type scheme": {
+			schemeType:  jiraIssueTypeSchemeType,
+			schemeId:    "10001",
+			forceDetach: true,
+			wantWrites:  []string{`PUT /rest/api/3/issuetypescheme/project {"issueTypeSchemeId":"10000","projectId":"10001"}`},
+		},
+		"issue field configuration scheme": {
+			schemeType:  jiraIssueFieldConfigurationSchemeType,
+			schemeId:    "10001",
+			forceDetach: true,
+			wantWrites:  []string{`PUT /rest/api/3/fieldconfigurationscheme/project {"fieldConfigurationSchemeId":null,"projectId":"10001"}`},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			client, writes := newTestSchemeProjectsServer(t)
+
+			diags := tt.schemeType.detachProjects(ctx, client, tt.schemeId, "test", tt.forceDetach)
+			if got := diags.HasError(); got != tt.wantError {
+				t.Fatalf("expected error %t, got: %v", tt.wantError, diags)
+			}
+			if tt.wantError && !strings.Contains(diags.Errors()[0].Detail(), "TEST1") {
+				t.Errorf("expected error listing project TEST1, got: %s", diags.Errors()[0].Detail())
+			}
+			if strings.Join(*writes, "\n") != strings.Join(tt.wantWrites, "\n") {
+				t.Errorf("expected requests %q, got: %q", tt.wantWrites, *writes)
+			}
+		})
+	}
+}
+
+func TestJiraScreenSchemeResource_Delete(t *testing.T) {
+	ctx := context.Background()
+	client, writes := newTestSchemeProjectsServer(t)
+
+	r := NewJiraScreenSchemeResource()
+	r.(resource.ResourceWithConfigure).Configure(ctx, resource.ConfigureRequest{ProviderData: &atlassianProvider{jira: client}}, &resource.ConfigureResponse{})
+
+	state := testResourceState(t, r, map[string]string{"id": "10000", "name": "test"})
+	resp := &resource.DeleteResponse{State: state}
+	r.Delete(ctx, resource.DeleteRequest{State: state}, resp)
+	if !resp.Diagnostics.HasError() || !strings.Contains(resp.Diagnostics.Errors()[0].Detail(), "Test Issue Type Screen Scheme") {
+		t.Fatalf("expected error listing the issue type screen scheme, got: %v", resp.Diagnostics)
+	}
+	if len(*writes) != 0 {
+		t.Errorf("expected no delete request, got: %q", *writes)
+	}
+}
@@ -0,0 +1,177 @@
+package atlassian
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+type (
+	// atlassianSite is a site configured in the `sites` attribute of the provider.
+	atlassianSite struct {
+		url      *url.URL
+		username string
+		apitoken string
+	}
+
+	atlassianSiteModel struct {
+		Url      types.String `tfsdk:"url"`
+		Username types.String `tfsdk:"username"`
+		ApiToken types.String `tfsdk:"apitoken"`
+	}
+
+	// siteContextKey is the context key of the name of the site selected by the `site` attribute.
+	siteContextKey struct{}
+)
+
+// newAtlassianSites returns the sites configured in the `sites` attribute of the provider.
+func newAtlassianSites(models map[string]atlassianSiteModel) (map[string]*atlassianSite, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	sites := make(map[string]*atlassianSite, len(models))
+
+	for name, m := range models {
+		if m.Url.IsUnknown() || m.Username.IsUnknown() || m.ApiToken.IsUnknown() {
+			diags.AddAttributeError(path.Root("sites").AtMapKey(name),
+				"Unable to create client.",
+				fmt.Sprintf("Cannot use unknown values in the settings of site %q.", name))
+			continue
+		}
+
+		u, err := url.Parse(m.Url.ValueString())
+		if err != nil {
+			diags.AddAttributeError(path.Root("sites").AtMapKey(name).AtName("url"),
+				"Unable to create client.",
+				fmt.Sprintf("Unable to parse the URL of site %q: %s", name, err))
+			continue
+		}
+		// The paths of the API endpoints are relative to the site URL
+		if !strings.HasSuffix(u.Path, "/") {
+			u.Path += "/"
+		}
+
+		sites[name] = &atlassianSite{
+			url:      u,
+			username: m.Username.ValueString(),
+			apitoken: m.ApiToken.ValueString(),
+		}
+	}
+
+	return sites, diags
+}
+
+// withSite returns ctx selecting the site with the given name for the API requests made with it.
+// A null or empty name selects the site of the provider `url`.
+func withSite(ctx context.Context, site types.String) context.Context {
+	if site.IsNull() || site.IsUnknown() || site.ValueString() == "" {
+		return ctx
+	}
+	ctx = tflog.SetField(ctx, "site", site.ValueString())
+	return context.WithValue(ctx, siteContextKey{}, site.ValueString())
+}
+
+// siteHost returns the host of the site selected by ctx.
+func (p *atlassianProvider) siteHost(ctx context.Context) string {
+	if name, ok := ctx.Value(siteContextKey{}).(string); ok {
+		if site, ok := p.sites[name]; ok {
+			return site.url.Host
+		}
+	}
+	return p.jira.Site.Host
+}
+
+// importSite sets the `site` attribute of an imported resource whose import identifier is
+// prefixed with the name of a configured site and a colon, e.g. `staging:10000`, and returns
+// the identifier without the prefix.
+func (p *atlassianProvider) importSite(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) string {
+	name, id, found := strings.Cut(req.ID, ":")
+	if !found {
+		return req.ID
+	}
+	if _, ok := p.sites[name]; !ok {
+		return req.ID
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("site"), name)...)
+	return id
+}
+
+// resourceSiteAttribute returns the `site` attribute of the resources.
+func resourceSiteAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		MarkdownDescription: "The name of the site managing the resource, as configured in the `sites` attribute of the provider. " +
+			"Defaults to the site of the provider `url`. Changing it forces a new resource.",
+		Optional: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+		},
+	}
+}
+
+// dataSourceSiteAttribute returns the `site` attribute of the data sources.
+func dataSourceSiteAttribute() datasourceschema.StringAttribute {
+	return datasourceschema.StringAttribute{
+		MarkdownDescription: "The name of the site to read from, as configured in the `sites` attribute of the provider. " +
+			"Defaults to the site of the provider `url`.",
+		Optional: true,
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+		},
+	}
+}
+
+// siteTransport is an http.RoundTripper that sends the requests whose context selects a site,
+// see withSite, to that site with its credentials, instead of the site of the provider `url`.
+type siteTransport struct {
+	transport http.RoundTripper
+	// base is the URL of the site of the provider `url`, which the requests are made to.
+	base  *url.URL
+	sites map[string]*atlassianSite
+}
+
+var _ http.RoundTripper = (*siteTransport)(nil)
+
+func newSiteTransport(transport http.RoundTripper, base *url.URL, sites map[string]*atlassianSite) *siteTransport {
+	return &siteTransport{
+		transport: transport,
+		base:      base,
+		sites:     sites,
+	}
+}
+
+func (t *siteTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	name, ok := req.Context().Value(siteContextKey{}).(string)
+	if !ok {
+		return t.transport.RoundTrip(req)
+	}
+	site, ok := t.sites[name]
+	if !ok {
+		return nil, fmt.Errorf("site %q is not configured in the `sites` attribute of the provider", name)
+	}
+
+	req = req.Clone(req.Context())
+	req.URL.Scheme = site.url.Scheme
+	req.URL.Host = site.url.Host
+	req.URL.Path = site.url.Path + strings.TrimPrefix(req.URL.Path, t.base.Path)
+	req.URL.RawPath = ""
+	req.Host = ""
+	req.SetBasicAuth(site.username, site.apitoken)
+
+	return t.transport.RoundTrip(req)
+}
@@ -0,0 +1,196 @@
+package atlassian
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ctreminiom/go-atlassian/confluence"
+	"github.com/ctreminiom/go-atlassian/jira/agile"
+	"github.com/ctreminiom/go-atlassian/jira/sm"
+	jira "github.com/ctreminiom/go-atlassian/jira/v3"
+	"github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// newTestSiteServer returns a server responding with the name of the site, the path and the
+// username of each request.
+func newTestSiteServer(t *testing.T, name string) *httptest.Server {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		username, _, _ := r.BasicAuth()
+		_, _ = io.WriteString(w, name+" "+r.URL.Path+" "+username)
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestSiteTransport(t *testing.T) {
+	defaultSrv := newTestSiteServer(t, "default")
+	stagingSrv := newTestSiteServer(t, "staging")
+
+	sites, diags := newAtlassianSites(map[string]atlassianSiteModel{
+		"staging": {
+			Url:      types.StringValue(stagingSrv.URL + "/jira"),
+			Username: types.StringValue("staging-user"),
+			ApiToken: types.StringValue("staging-token"),
+		},
+	})
+	if diags.HasError() {
+		t.Fatalf("unable to create sites: %v", diags)
+	}
+
+	httpClient := &http.Client{Transport: http.DefaultTransport}
+	client, err := jira.New(httpClient, defaultSrv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client.Auth.SetBasicAuth("default-user", "default-token")
+	httpClient.Transport = newSiteTransport(httpClient.Transport, client.Site, sites)
+
+	tests := map[string]struct {
+		site    types.String
+		want    string
+		wantErr string
+	}{
+		"default":      {site: types.StringNull(), want: "default /rest/api/3/serverInfo default-user"},
+		"empty":        {site: types.StringValue(""), want: "default /rest/api/3/serverInfo default-user"},
+		"site":         {site: types.StringValue("staging"), want: "staging /jira/rest/api/3/serverInfo staging-user"},
+		"unknown site": {site: types.StringValue("production"), wantErr: `site "production" is not configured`},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := withSite(context.Background(), tt.site)
+
+			req, err := client.NewRequest(ctx, http.MethodGet, "rest/api/3/serverInfo", nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			res, err := client.Call(req, nil)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("expected error containing %q, got: %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := res.Bytes.String(); got != tt.want {
+				t.Errorf("expected response %q, got: %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestSiteTransport_Clients(t *testing.T) {
+	defaultSrv := newTestSiteServer(t, "default")
+	stagingSrv := newTestSiteServer(t, "staging")
+
+	sites, diags := newAtlassianSites(map[string]atlassianSiteModel{
+		"staging": {
+			Url:      types.StringValue(stagingSrv.URL),
+			Username: types.StringValue("staging-user"),
+			ApiToken: types.StringValue("staging-token"),
+		},
+	})
+	if diags.HasError() {
+		t.Fatalf("unable to create sites: %v", diags)
+	}
+
+	// The clients of all APIs share the HTTP client, and so the site transport
+	httpClient := &http.Client{Transport: http.DefaultTransport}
+	agileClient, err := agile.New(httpClient, defaultSrv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	smClient, err := sm.New(httpClient, defaultSrv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	confluenceClient, err := confluence.New(httpClient, defaultSrv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	httpClient.Transport = newSiteTransport(httpClient.Transport, agileClient.Site, sites)
+
+	type client interface {
+		NewRequest(ctx context.Context, method, apiEndpoint string, payload io.Reader) (*http.Request, error)
+		Call(request *http.Request, structure interface{}) (*models.ResponseScheme, error)
+	}
+	tests := map[string]struct {
+		client   client
+		endpoint string
+		want     string
+	}{
+		"agile":              {client: agileClient, endpoint: "rest/agile/1.0/board", want: "staging /rest/agile/1.0/board staging-user"},
+		"service management": {client: smClient, endpoint: "rest/servicedeskapi/servicedesk", want: "staging /rest/servicedeskapi/servicedesk staging-user"},
+		"confluence":         {client: confluenceClient, endpoint: "wiki/rest/api/space", want: "staging /wiki/rest/api/space staging-user"},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := withSite(context.Background(), types.StringValue("staging"))
+
+			req, err := tt.client.NewRequest(ctx, http.MethodGet, tt.endpoint, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			res, err := tt.client.Call(req, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := res.Bytes.String(); got != tt.want {
+				t.Errorf("expected response %q, got: %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestAtlassianProviderImportSite(t *testing.T) {
+	ctx := context.Background()
+	p := atlassianProvider{sites: map[string]*atlassianSite{"staging": {}}}
+
+	tests := map[string]struct {
+		id       string
+		wantID   string
+		wantSite types.String
+	}{
+		"default site":   {id: "10000", wantID: "10000", wantSite: types.StringNull()},
+		"site":           {id: "staging:10000", wantID: "10000", wantSite: types.StringValue("staging")},
+		"site and parts": {id: "staging:10000,10001", wantID: "10000,10001", wantSite: types.StringValue("staging")},
+		"unknown site":   {id: "group:name", wantID: "group:name", wantSite: types.StringNull()},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			r := NewJiraProjectCategoryResource()
+			schemaResp := &resource.SchemaResponse{}
+			r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+			resp := &resource.ImportStateResponse{
+				State: tfsdk.State{
+					Schema: schemaResp.Schema,
+					Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+				},
+			}
+
+			if got := p.importSite(ctx, resource.ImportStateRequest{ID: tt.id}, resp); got != tt.wantID {
+				t.Errorf("expected ID %q, got: %q", tt.wantID, got)
+			}
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+
+			var site types.String
+			resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("site"), &site)...)
+			if !site.Equal(tt.wantSite) {
+				t.Errorf("expected site %s, got: %s", tt.wantSite, site)
+			}
+		})
+	}
+}
@@ -0,0 +1,149 @@
+package atlassian
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestUpgradeStateFromVersion1_CompositeID(t *testing.T) {
+	tests := []struct {
+		TestName string
+		TypeName string
+		Input    string
+		Expect   string
+	}{
+		{
+			TestName: "group user",
+			TypeName: "atlassian_jira_group_user",
+			Input:    `{"id": "jira-administrators-5b10ac8d82e05b22cc7d4ef5", "group_name": "jira-administrators", "account_id": "5b10ac8d82e05b22cc7d4ef5", "self": "https://example.atlassian.net/rest/api/3/user?accountId=5b10ac8d82e05b22cc7d4ef5", "email_address": "mia@example.com", "avatar_urls": {"p16x16": "16", "p24x24": "24", "p32x32": "32", "p48x48": "48"}, "display_name": "Mia", "active": true, "timezone": "Europe/Madrid", "account_type": "atlassian"}`,
+			Expect:   "jira-administrators,5b10ac8d82e05b22cc7d4ef5",
+		},
+		{
+			TestName: "issue field configuration item",
+			TypeName: "atlassian_jira_issue_field_configuration_item",
+			Input:    `{"id": "10000-customfield_10001", "issue_field_configuration": "10000", "item": {"id": "customfield_10001", "description": "", "is_hidden": false, "is_required": true, "renderer": "text-renderer"}}`,
+			Expect:   "10000,customfield_10001",
+		},
+		{
+			TestName: "issue field configuration scheme mapping",
+			TypeName: "atlassian_jira_issue_field_configuration_scheme_mapping",
+			Input:    `{"id": "10000-10001-default", "field_configuration_scheme_id": "10000", "field_configuration_id": "10001", "issue_type_id": "default"}`,
+			Expect:   "10000,10001,default",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.TestName, func(t *testing.T) {
+			state := testUpgradeResourceState(t, tt.TypeName, 1, tt.Input)
+
+			var id string
+			if err := state["id"].As(&id); err != nil {
+				t.Fatal(err)
+			}
+			if id != tt.Expect {
+				t.Errorf("got %s, expected %s", id, tt.Expect)
+			}
+		})
+	}
+}
+
+func TestUpgradeStateFromVersion1_KeepsAttributes(t *testing.T) {
+	typeName := "atlassian_jira_issue_field_configuration_item"
+	attributes := `"issue_field_configuration": "10000", "item": {"id": "summary", "description": "The summary", "is_hidden": false, "is_required": true, "renderer": "wiki-renderer"}`
+
+	upgraded, typ := testUpgradeResourceStateValue(t, typeName, 1, `{"id": "10000-summary", `+attributes+`}`)
+	expected, err := (&tfprotov6.RawState{JSON: []byte(`{"id": "10000,summary", ` + attributes + `, "site": null}`)}).Unmarshal(typ)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !upgraded.Equal(expected) {
+		t.Errorf("got %s, expected %s", upgraded, expected)
+	}
+}
+
+// TestResources_UpgradeStateFromVersion1 ensures that the state written by every resource at
+// schema version 1 can be upgraded to its current schema version, or needs no upgrade when the
+// resource is still at version 1.
+func TestResources_UpgradeStateFromVersion1(t *testing.T) {
+	ctx := context.Background()
+	server, err := providerserver.NewProtocol6WithError(New("test")())()
+	if err != nil {
+		t.Fatal(err)
+	}
+	schemaResp, err := server.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for typeName, resourceSchema := range schemaResp.ResourceSchemas {
+		t.Run(typeName, func(t *testing.T) {
+			resp, err := server.UpgradeResourceState(ctx, &tfprotov6.UpgradeResourceStateRequest{
+				TypeName: typeName,
+				Version:  1,
+				RawState: &tfprotov6.RawState{JSON: []byte(`{"id": "10000"}`)},
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, d := range resp.Diagnostics {
+				t.Errorf("schema version %d: unexpected diagnostic: %s: %s", resourceSchema.Version, d.Summary, d.Detail)
+			}
+		})
+	}
+}
+
+// testUpgradeResourceState upgrades the raw state of a resource in JSON from the given schema
+// version with the provider server, and returns the attributes of the upgraded state.
+func testUpgradeResourceState(t *testing.T, typeName string, version int64, rawState string) map[string]tftypes.Value {
+	t.Helper()
+
+	value, _ := testUpgradeResourceStateValue(t, typeName, version, rawState)
+	var state map[string]tftypes.Value
+	if err := value.As(&state); err != nil {
+		t.Fatal(err)
+	}
+	return state
+}
+
+// testUpgradeResourceStateValue upgrades the raw state of a resource like testUpgradeResourceState,
+// and returns the upgraded state and the type of the current schema.
+func testUpgradeResourceStateValue(t *testing.T, typeName string, version int64, rawState string) (tftypes.Value, tftypes.Type) {
+	t.Helper()
+	ctx := context.Background()
+
+	server, err := providerserver.NewProtocol6WithError(New("test")())()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	schemaResp, err := server.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	resourceSchema, ok := schemaResp.ResourceSchemas[typeName]
+	if !ok {
+		t.Fatalf("resource %s not found", typeName)
+	}
+
+	resp, err := server.UpgradeResourceState(ctx, &tfprotov6.UpgradeResourceStateRequest{
+		TypeName: typeName,
+		Version:  version,
+		RawState: &tfprotov6.RawState{JSON: []byte(rawState)},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, d := range resp.Diagnostics {
+		t.Fatalf("unexpected diagnostic: %s: %s", d.Summary, d.Detail)
+	}
+
+	value, err := resp.UpgradedState.Unmarshal(resourceSchema.ValueType())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return value, resourceSchema.ValueType()
+}
@@ -0,0 +1,76 @@
+package atlassian
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+)
+
+// transportConfig holds the provider settings used to build the HTTP transport of the Atlassian clients.
+type transportConfig struct {
+	ProxyURL           string
+	CACertPEM          string
+	CACertFile         string
+	ClientCertPEM      string
+	ClientKeyPEM       string
+	InsecureSkipVerify bool
+}
+
+// newHTTPClient returns an HTTP client whose transport uses the proxy, certificate authorities and
+// client certificate of config. Without a proxy URL, the proxy is read from the HTTPS_PROXY,
+// HTTP_PROXY and NO_PROXY environment variables.
+func newHTTPClient(config transportConfig) (*http.Client, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+
+	if config.ProxyURL != "" {
+		proxyURL, err := url.Parse(config.ProxyURL)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse proxy_url: %w", err)
+		}
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+
+	tlsConfig := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		// #nosec G402 -- only used when explicitly enabled by the user, who is warned about it.
+		InsecureSkipVerify: config.InsecureSkipVerify,
+	}
+
+	caCertPEM := []byte(config.CACertPEM)
+	if config.CACertFile != "" {
+		b, err := os.ReadFile(config.CACertFile)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read ca_cert_file: %w", err)
+		}
+		caCertPEM = b
+	}
+	if len(caCertPEM) != 0 {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(caCertPEM) {
+			return nil, errors.New("unable to parse CA certificate: no PEM encoded certificates found")
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if config.ClientCertPEM != "" || config.ClientKeyPEM != "" {
+		if config.ClientCertPEM == "" || config.ClientKeyPEM == "" {
+			return nil, errors.New("client_cert_pem and client_key_pem must be set together")
+		}
+		cert, err := tls.X509KeyPair([]byte(config.ClientCertPEM), []byte(config.ClientKeyPEM))
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	transport.TLSClientConfig = tlsConfig
+
+	return &http.Client{Transport: transport}, nil
+}
@@ -0,0 +1,79 @@
+package atlassian
+
+import (
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestNewHTTPClient_CACert(t *testing.T) {
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer srv.Close()
+
+	caCertPEM := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw}))
+	caCertFile := filepath.Join(t.TempDir(), "ca.pem")
+	if err := os.WriteFile(caCertFile, []byte(caCertPEM), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := map[string]struct {
+		config  transportConfig
+		wantErr bool
+	}{
+		"system CAs":           {config: transportConfig{}, wantErr: true},
+		"ca_cert_pem":          {config: transportConfig{CACertPEM: caCertPEM}},
+		"ca_cert_file":         {config: transportConfig{CACertFile: caCertFile}},
+		"insecure_skip_verify": {config: transportConfig{InsecureSkipVerify: true}},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			client, err := newHTTPClient(tt.config)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			res, err := client.Get(srv.URL)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("expected error: %t, got: %v", tt.wantErr, err)
+			}
+			if res != nil {
+				res.Body.Close()
+			}
+		})
+	}
+}
+
+func TestNewHTTPClient_Errors(t *testing.T) {
+	tests := map[string]transportConfig{
+		"invalid ca_cert_pem":     {CACertPEM: "foo"},
+		"missing ca_cert_file":    {CACertFile: filepath.Join(t.TempDir(), "missing.pem")},
+		"client cert without key": {ClientCertPEM: "foo"},
+		"invalid client cert":     {ClientCertPEM: "foo", ClientKeyPEM: "bar"},
+		"invalid proxy_url":       {ProxyURL: "http://[::1"},
+	}
+	for name, config := range tests {
+		t.Run(name, func(t *testing.T) {
+			if _, err := newHTTPClient(config); err == nil {
+				t.Fatal("expected error, got none")
+			}
+		})
+	}
+}
+
+func TestNewHTTPClient_Proxy(t *testing.T) {
+	client, err := newHTTPClient(transportConfig{ProxyURL: "http://proxy.example.com:3128"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	req, _ := http.NewRequest(http.MethodGet, "https://foo-bar.atlassian.net", nil)
+	proxyURL, err := client.Transport.(*http.Transport).Proxy(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if proxyURL == nil || proxyURL.String() != "http://proxy.example.com:3128" {
+		t.Fatalf("expected proxy http://proxy.example.com:3128, got: %v", proxyURL)
+	}
+}
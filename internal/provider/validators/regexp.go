@@ -0,0 +1,39 @@
+package validators
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.String = (*validRegexpValidator)(nil)
+
+type validRegexpValidator struct{}
+
+func (v validRegexpValidator) Description(ctx context.Context) string {
+	return v.MarkdownDescription(ctx)
+}
+
+func (v validRegexpValidator) MarkdownDescription(_ context.Context) string {
+	return "Must be a valid regular expression"
+}
+
+func (v validRegexpValidator) ValidateString(ctx context.Context, req validator.StringRequest, res *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := regexp.Compile(req.ConfigValue.ValueString()); err != nil {
+		res.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Regular Expression",
+			fmt.Sprintf("Parsing regular expression %q failed: %v", req.ConfigValue.ValueString(), err),
+		)
+	}
+}
+
+func ValidRegexp() validator.String {
+	return validRegexpValidator{}
+}
@@ -0,0 +1,146 @@
+// Package changelog adds the entries of new resources and data sources to the FEATURES section of
+// the unreleased version in CHANGELOG.md.
+package changelog
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/openscientia/terraform-provider-atlassian/tfwaff/register"
+)
+
+// followingSections are the sections of a version following the FEATURES section.
+var followingSections = []string{"ENHANCEMENTS:", "BUG FIXES:"}
+
+// Entry adds the entry, e.g. "* **New Resource:** `atlassian_jira_foo`", to the FEATURES section
+// of the unreleased version in filename, keeping the section sorted. Unless dryRun is set,
+// filename is rewritten. It returns the diff of the change, which is empty when the entry is
+// already present.
+func Entry(filename, entry string, dryRun bool) (string, error) {
+	src, err := os.ReadFile(filename)
+	if err != nil {
+		return "", fmt.Errorf("reading changelog: %w", err)
+	}
+
+	out, err := Insert(src, entry)
+	if err != nil {
+		return "", fmt.Errorf("adding entry to %s: %w", filename, err)
+	}
+	if bytes.Equal(src, out) {
+		return "", nil
+	}
+
+	if !dryRun {
+		info, err := os.Stat(filename)
+		if err != nil {
+			return "", err
+		}
+		if err := os.WriteFile(filename, out, info.Mode()); err != nil {
+			return "", fmt.Errorf("writing changelog: %w", err)
+		}
+	}
+
+	return register.Diff(filename, src, out), nil
+}
+
+// Insert returns src with the entry added to the FEATURES section of the first version, which
+// must be unreleased. The entry is inserted before the first entry sorting after it, ignoring the
+// issue links of the entries, and the section is created if missing.
+func Insert(src []byte, entry string) ([]byte, error) {
+	lines := strings.SplitAfter(string(src), "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+
+	// Bounds of the first version
+	start := -1
+	end := len(lines)
+	for i, l := range lines {
+		if !strings.HasPrefix(l, "## ") {
+			continue
+		}
+		if start >= 0 {
+			end = i
+			break
+		}
+		if !strings.Contains(l, "(Unreleased)") {
+			return nil, fmt.Errorf("latest version %q is not unreleased", strings.TrimSpace(l))
+		}
+		start = i
+	}
+	if start < 0 {
+		return nil, fmt.Errorf("no version found")
+	}
+
+	section := -1
+	for i := start + 1; i < end; i++ {
+		if strings.TrimSpace(lines[i]) == "FEATURES:" {
+			section = i
+			break
+		}
+	}
+
+	var insert []string
+	var at int
+	if section < 0 {
+		insert = []string{"FEATURES:\n", "\n", entry + "\n", "\n"}
+		at = sectionPosition(lines, start, end)
+		if strings.TrimSpace(lines[at-1]) != "" {
+			insert = append([]string{"\n"}, insert...)
+		}
+	} else {
+		insert = []string{entry + "\n"}
+		at = section + 1
+		for at < end && strings.TrimSpace(lines[at]) == "" {
+			at++
+		}
+		key := sortKey(entry)
+		for ; at < end && strings.HasPrefix(lines[at], "* "); at++ {
+			existing := sortKey(lines[at])
+			if existing == key {
+				return src, nil
+			}
+			if existing > key {
+				break
+			}
+		}
+	}
+
+	var buf strings.Builder
+	for _, l := range lines[:at] {
+		buf.WriteString(l)
+	}
+	for _, l := range insert {
+		buf.WriteString(l)
+	}
+	for _, l := range lines[at:] {
+		buf.WriteString(l)
+	}
+	return []byte(buf.String()), nil
+}
+
+// sectionPosition returns the line of the version between start and end where a missing
+// FEATURES section is inserted: before the first section following it, or at the end of the
+// version.
+func sectionPosition(lines []string, start, end int) int {
+	for i := start + 1; i < end; i++ {
+		for _, s := range followingSections {
+			if strings.TrimSpace(lines[i]) == s {
+				return i
+			}
+		}
+	}
+	return end
+}
+
+// sortKey returns the entry without its issue link and code spans, so that e.g.
+// `atlassian_jira_group` sorts before `atlassian_jira_group_user`.
+func sortKey(entry string) string {
+	entry = strings.TrimSpace(entry)
+	if i := strings.Index(entry, " (["); i >= 0 {
+		entry = entry[:i]
+	}
+	return strings.ReplaceAll(entry, "`", "")
+}
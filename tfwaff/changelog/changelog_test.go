@@ -0,0 +1,133 @@
+package changelog
+
+import (
+	"testing"
+)
+
+const src = `## 0.2.0 (Unreleased)
+
+FEATURES:
+
+* **New Data Source:** ` + "`atlassian_jira_group`" + ` ([#160](https://github.com/openscientia/terraform-provider-atlassian/issues/160))
+* **New Resource:** ` + "`atlassian_jira_group`" + ` ([#148](https://github.com/openscientia/terraform-provider-atlassian/issues/148))
+* **New Resource:** ` + "`atlassian_jira_status`" + ` ([#113](https://github.com/openscientia/terraform-provider-atlassian/issues/113))
+
+ENHANCEMENTS:
+
+* provider: Add ` + "`url`" + ` attribute validation.
+
+## 0.1.0 (July 16, 2022)
+
+FEATURES:
+
+* **New Resource:** ` + "`atlassian_jira_issue_type`" + `
+`
+
+func TestInsert(t *testing.T) {
+	tests := []struct {
+		TestName string
+		Input    string
+		Expect   string
+	}{
+		{
+			TestName: "sorted",
+			Input:    "* **New Resource:** `atlassian_jira_group_user`",
+			Expect: `## 0.2.0 (Unreleased)
+
+FEATURES:
+
+* **New Data Source:** ` + "`atlassian_jira_group`" + ` ([#160](https://github.com/openscientia/terraform-provider-atlassian/issues/160))
+* **New Resource:** ` + "`atlassian_jira_group`" + ` ([#148](https://github.com/openscientia/terraform-provider-atlassian/issues/148))
+* **New Resource:** ` + "`atlassian_jira_group_user`" + `
+* **New Resource:** ` + "`atlassian_jira_status`" + ` ([#113](https://github.com/openscientia/terraform-provider-atlassian/issues/113))
+
+ENHANCEMENTS:
+
+* provider: Add ` + "`url`" + ` attribute validation.
+
+## 0.1.0 (July 16, 2022)
+
+FEATURES:
+
+* **New Resource:** ` + "`atlassian_jira_issue_type`" + `
+`,
+		},
+		{
+			TestName: "last",
+			Input:    "* **New Resource:** `atlassian_jira_workflow`",
+			Expect: `## 0.2.0 (Unreleased)
+
+FEATURES:
+
+* **New Data Source:** ` + "`atlassian_jira_group`" + ` ([#160](https://github.com/openscientia/terraform-provider-atlassian/issues/160))
+* **New Resource:** ` + "`atlassian_jira_group`" + ` ([#148](https://github.com/openscientia/terraform-provider-atlassian/issues/148))
+* **New Resource:** ` + "`atlassian_jira_status`" + ` ([#113](https://github.com/openscientia/terraform-provider-atlassian/issues/113))
+* **New Resource:** ` + "`atlassian_jira_workflow`" + `
+
+ENHANCEMENTS:
+
+* provider: Add ` + "`url`" + ` attribute validation.
+
+## 0.1.0 (July 16, 2022)
+
+FEATURES:
+
+* **New Resource:** ` + "`atlassian_jira_issue_type`" + `
+`,
+		},
+		{
+			TestName: "already present with issue link",
+			Input:    "* **New Resource:** `atlassian_jira_status`",
+			Expect:   src,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.TestName, func(t *testing.T) {
+			out, err := Insert([]byte(src), tt.Input)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(out) != tt.Expect {
+				t.Errorf("got\n%s\nexpected\n%s", out, tt.Expect)
+			}
+		})
+	}
+}
+
+func TestInsert_MissingSection(t *testing.T) {
+	tests := []struct {
+		TestName string
+		Input    string
+		Expect   string
+	}{
+		{
+			TestName: "before following section",
+			Input:    "## 0.2.0 (Unreleased)\n\nNOTES:\n\n* foo\n\nBUG FIXES:\n\n* bar\n",
+			Expect:   "## 0.2.0 (Unreleased)\n\nNOTES:\n\n* foo\n\nFEATURES:\n\n* **New Resource:** `atlassian_jira_foo`\n\nBUG FIXES:\n\n* bar\n",
+		},
+		{
+			TestName: "end of version",
+			Input:    "## 0.2.0 (Unreleased)\n\nNOTES:\n\n* foo\n\n## 0.1.0 (July 16, 2022)\n",
+			Expect:   "## 0.2.0 (Unreleased)\n\nNOTES:\n\n* foo\n\nFEATURES:\n\n* **New Resource:** `atlassian_jira_foo`\n\n## 0.1.0 (July 16, 2022)\n",
+		},
+		{
+			TestName: "released",
+			Input:    "## 0.1.0 (July 16, 2022)\n",
+			Expect:   `latest version "## 0.1.0 (July 16, 2022)" is not unreleased`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.TestName, func(t *testing.T) {
+			out, err := Insert([]byte(tt.Input), "* **New Resource:** `atlassian_jira_foo`")
+			result := string(out)
+			if err != nil {
+				result = err.Error()
+			}
+			if result != tt.Expect {
+				t.Errorf("got\n%s\nexpected\n%s", result, tt.Expect)
+			}
+		})
+	}
+}
@@ -0,0 +1,399 @@
+// Package check reports the violations of the conventions of the provider by its resources and
+// data sources, e.g. attributes without MarkdownDescription or missing examples.
+package check
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/openscientia/terraform-provider-atlassian/tfwaff/register"
+)
+
+// The rules checked, named in the violations.
+const (
+	RuleImportState   = "import-state"
+	RuleDescription   = "description"
+	RuleSchemaVersion = "schema-version"
+	RuleExample       = "example"
+	RuleRegistered    = "registered"
+	RuleTest          = "test"
+)
+
+// ignoreDirective is the prefix of the comments disabling a rule for a file, followed by the rule
+// and the reason, e.g. //tfwaff:ignore import-state avatars cannot be read by ID.
+const ignoreDirective = "//tfwaff:ignore "
+
+// Violation is a breach of a convention by a resource or data source.
+type Violation struct {
+	// File is the Go file of the resource or data source.
+	File    string
+	Rule    string
+	Message string
+}
+
+func (v Violation) String() string {
+	return fmt.Sprintf("%s: %s [%s]", v.File, v.Message, v.Rule)
+}
+
+// kind holds the conventions which differ between resources and data sources.
+type kind struct {
+	// filePrefix is the prefix of the Go files, e.g. resource_.
+	filePrefix string
+	// iface is the interface returned by the constructor, e.g. resource.Resource.
+	pkg, iface string
+	// method is the method of the provider registering the constructors.
+	method string
+	// examples is the directory of the examples in the examples directory.
+	examples string
+	// testSuffix follows the name in the names of the acceptance tests, e.g. TestAccJiraGroupDataSource_Basic.
+	testSuffix string
+	// resource is set for resources, which implement ImportState and have a schema version.
+	resource bool
+}
+
+var kinds = []kind{
+	{
+		filePrefix: "resource_",
+		pkg:        "resource",
+		iface:      "Resource",
+		method:     "Resources",
+		examples:   "resources",
+		resource:   true,
+	},
+	{
+		filePrefix: "data_source_",
+		pkg:        "datasource",
+		iface:      "DataSource",
+		method:     "DataSources",
+		examples:   "data-sources",
+		testSuffix: "DataSource",
+	},
+}
+
+// Run checks the resources and data sources in dir, i.e. internal/provider, and their examples in
+// the examples directory of root, the root of the repository. The violations are sorted by file.
+func Run(dir, root string) ([]Violation, error) {
+	providerSrc, err := os.ReadFile(filepath.Join(dir, "provider.go"))
+	if err != nil {
+		return nil, fmt.Errorf("reading provider file: %w", err)
+	}
+
+	var violations []Violation
+	for _, k := range kinds {
+		registered, err := register.Constructors(providerSrc, k.method)
+		if err != nil {
+			return nil, err
+		}
+
+		files, err := filepath.Glob(filepath.Join(dir, k.filePrefix+"*.go"))
+		if err != nil {
+			return nil, err
+		}
+		for _, filename := range files {
+			if strings.HasSuffix(filename, "_test.go") {
+				continue
+			}
+			v, err := checkFile(filename, root, k, registered)
+			if err != nil {
+				return nil, err
+			}
+			violations = append(violations, v...)
+		}
+	}
+
+	sort.SliceStable(violations, func(i, j int) bool {
+		return violations[i].File < violations[j].File
+	})
+	return violations, nil
+}
+
+// checkFile checks the resource or data source defined in filename.
+func checkFile(filename, root string, k kind, registered []string) ([]Violation, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, filename, nil, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+
+	constructor := findConstructor(file, k)
+	if constructor == "" {
+		// Not a resource or data source, e.g. shared helpers
+		return nil, nil
+	}
+	name := strings.TrimSuffix(strings.TrimPrefix(constructor, "New"), k.iface)
+
+	ignored, err := ignoredRules(file)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", filename, err)
+	}
+
+	var violations []Violation
+	report := func(rule, format string, args ...interface{}) {
+		if ignored[rule] {
+			return
+		}
+		violations = append(violations, Violation{File: filename, Rule: rule, Message: fmt.Sprintf(format, args...)})
+	}
+
+	if !contains(registered, constructor) {
+		report(RuleRegistered, "%s is not registered in the %s method of the provider", constructor, k.method)
+	}
+
+	if k.resource {
+		if !hasInterfaceAssertion(file, k.pkg, "ResourceWithImportState") {
+			report(RuleImportState, "missing var _ resource.ResourceWithImportState assertion")
+		} else if !callsImportState(file) {
+			report(RuleImportState, "method ImportState does not call the importState method of the provider")
+		}
+	}
+
+	schema := findSchema(file)
+	if schema == nil {
+		report(RuleDescription, "method Schema does not set a schema.Schema")
+	} else {
+		if k.resource && field(schema, "Version") == nil {
+			report(RuleSchemaVersion, "schema has no Version")
+		}
+		if field(schema, "MarkdownDescription") == nil {
+			report(RuleDescription, "schema has no MarkdownDescription")
+		}
+		if attrs, ok := field(schema, "Attributes").(*ast.CompositeLit); ok {
+			for _, path := range undocumented(attrs, "") {
+				report(RuleDescription, "attribute %s has no MarkdownDescription", path)
+			}
+		}
+	}
+
+	if typeName := findTypeName(file); typeName != "" {
+		dir := filepath.Join(root, "examples", k.examples, "atlassian"+typeName)
+		if examples, _ := filepath.Glob(filepath.Join(dir, "*.tf")); len(examples) == 0 {
+			report(RuleExample, "no example in %s", dir)
+		}
+	}
+
+	testFilename := strings.TrimSuffix(filename, ".go") + "_test.go"
+	testPrefix := "TestAcc" + name + k.testSuffix + "_"
+	if tests, err := testNames(testFilename); err != nil {
+		report(RuleTest, "no test file %s", testFilename)
+	} else if !hasPrefix(tests, testPrefix) {
+		report(RuleTest, "no acceptance test named %s* in %s", testPrefix, testFilename)
+	}
+
+	return violations, nil
+}
+
+// ignoredRules returns the rules disabled by the ignore directives of the file, which must give a
+// reason.
+func ignoredRules(file *ast.File) (map[string]bool, error) {
+	ignored := map[string]bool{}
+	for _, group := range file.Comments {
+		for _, c := range group.List {
+			if !strings.HasPrefix(c.Text, ignoreDirective) {
+				continue
+			}
+			fields := strings.Fields(strings.TrimPrefix(c.Text, ignoreDirective))
+			if len(fields) < 2 {
+				return nil, fmt.Errorf("%q must give a rule and a reason", c.Text)
+			}
+			ignored[fields[0]] = true
+		}
+	}
+	return ignored, nil
+}
+
+// findConstructor returns the name of the function returning the resource or data source, e.g.
+// NewJiraGroupResource.
+func findConstructor(file *ast.File, k kind) string {
+	for _, decl := range file.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Recv != nil || !strings.HasPrefix(fn.Name.Name, "New") {
+			continue
+		}
+		results := fn.Type.Results
+		if results == nil || len(results.List) != 1 {
+			continue
+		}
+		if isSelector(results.List[0].Type, k.pkg, k.iface) {
+			return fn.Name.Name
+		}
+	}
+	return ""
+}
+
+// hasInterfaceAssertion reports whether the file asserts that a type implements pkg.iface, i.e.
+// var _ pkg.iface = (*T)(nil).
+func hasInterfaceAssertion(file *ast.File, pkg, iface string) bool {
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.VAR {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			vs := spec.(*ast.ValueSpec)
+			if len(vs.Names) == 1 && vs.Names[0].Name == "_" && isSelector(vs.Type, pkg, iface) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// callsImportState reports whether the ImportState method calls the importState method of the
+// provider, e.g. r.p.importState(ctx, req, resp, importAttribute{path.Root("id"), true}), which
+// parses and validates the import identifier.
+func callsImportState(file *ast.File) bool {
+	for _, decl := range file.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Recv == nil || fn.Name.Name != "ImportState" || fn.Body == nil {
+			continue
+		}
+		found := false
+		ast.Inspect(fn.Body, func(n ast.Node) bool {
+			if call, ok := n.(*ast.CallExpr); ok {
+				if sel, ok := call.Fun.(*ast.SelectorExpr); ok && sel.Sel.Name == "importState" {
+					found = true
+				}
+			}
+			return !found
+		})
+		return found
+	}
+	return false
+}
+
+// findSchema returns the schema.Schema literal set by the Schema method.
+func findSchema(file *ast.File) *ast.CompositeLit {
+	for _, decl := range file.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Recv == nil || fn.Name.Name != "Schema" || fn.Body == nil {
+			continue
+		}
+		var schema *ast.CompositeLit
+		ast.Inspect(fn.Body, func(n ast.Node) bool {
+			if lit, ok := n.(*ast.CompositeLit); ok && schema == nil && isSelector(lit.Type, "schema", "Schema") {
+				schema = lit
+			}
+			return schema == nil
+		})
+		return schema
+	}
+	return nil
+}
+
+// undocumented returns the paths of the attributes without MarkdownDescription in a map literal of
+// attributes, including nested attributes. Attributes defined by a function call are skipped.
+func undocumented(attrs *ast.CompositeLit, prefix string) []string {
+	var paths []string
+	for _, elt := range attrs.Elts {
+		kv, ok := elt.(*ast.KeyValueExpr)
+		if !ok {
+			continue
+		}
+		key, ok := kv.Key.(*ast.BasicLit)
+		if !ok || key.Kind != token.STRING {
+			continue
+		}
+		name, err := strconv.Unquote(key.Value)
+		if err != nil {
+			continue
+		}
+		lit, ok := kv.Value.(*ast.CompositeLit)
+		if !ok {
+			continue
+		}
+		if field(lit, "MarkdownDescription") == nil {
+			paths = append(paths, prefix+name)
+		}
+
+		nested := field(lit, "Attributes")
+		if obj, ok := field(lit, "NestedObject").(*ast.CompositeLit); ok {
+			nested = field(obj, "Attributes")
+		}
+		if nested, ok := nested.(*ast.CompositeLit); ok {
+			paths = append(paths, undocumented(nested, prefix+name+".")...)
+		}
+	}
+	return paths
+}
+
+// findTypeName returns the suffix of the type name set by the Metadata method, e.g. _jira_group.
+func findTypeName(file *ast.File) string {
+	for _, decl := range file.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Recv == nil || fn.Name.Name != "Metadata" || fn.Body == nil {
+			continue
+		}
+		var typeName string
+		ast.Inspect(fn.Body, func(n ast.Node) bool {
+			if lit, ok := n.(*ast.BasicLit); ok && lit.Kind == token.STRING && typeName == "" {
+				typeName, _ = strconv.Unquote(lit.Value)
+			}
+			return typeName == ""
+		})
+		return typeName
+	}
+	return ""
+}
+
+// testNames returns the names of the test functions in filename.
+func testNames(filename string) ([]string, error) {
+	file, err := parser.ParseFile(token.NewFileSet(), filename, nil, 0)
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	for _, decl := range file.Decls {
+		if fn, ok := decl.(*ast.FuncDecl); ok && fn.Recv == nil && strings.HasPrefix(fn.Name.Name, "Test") {
+			names = append(names, fn.Name.Name)
+		}
+	}
+	return names, nil
+}
+
+// field returns the value of the field with the given name in a struct literal, if any.
+func field(lit *ast.CompositeLit, name string) ast.Expr {
+	for _, elt := range lit.Elts {
+		kv, ok := elt.(*ast.KeyValueExpr)
+		if !ok {
+			continue
+		}
+		if ident, ok := kv.Key.(*ast.Ident); ok && ident.Name == name {
+			return kv.Value
+		}
+	}
+	return nil
+}
+
+func isSelector(expr ast.Expr, pkg, name string) bool {
+	sel, ok := expr.(*ast.SelectorExpr)
+	if !ok {
+		return false
+	}
+	ident, ok := sel.X.(*ast.Ident)
+	return ok && ident.Name == pkg && sel.Sel.Name == name
+}
+
+func contains(list []string, s string) bool {
+	for _, l := range list {
+		if l == s {
+			return true
+		}
+	}
+	return false
+}
+
+func hasPrefix(list []string, prefix string) bool {
+	for _, l := range list {
+		if strings.HasPrefix(l, prefix) {
+			return true
+		}
+	}
+	return false
+}
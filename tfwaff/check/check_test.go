@@ -0,0 +1,85 @@
+package check
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+func TestRun(t *testing.T) {
+	violations, err := Run(filepath.Join("testdata", "internal", "provider"), "testdata")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dataSource := filepath.Join("testdata", "internal", "provider", "data_source_jira_foo.go")
+	importResource := filepath.Join("testdata", "internal", "provider", "resource_jira_baz.go")
+	resource := filepath.Join("testdata", "internal", "provider", "resource_jira_foo.go")
+	tests := []struct {
+		TestName string
+		Input    Violation
+		Expect   string
+	}{
+		{
+			TestName: "data source not registered",
+			Input:    violations[0],
+			Expect:   dataSource + ": NewJiraFooDataSource is not registered in the DataSources method of the provider [registered]",
+		},
+		{
+			TestName: "data source example",
+			Input:    violations[1],
+			Expect:   dataSource + ": no example in " + filepath.Join("testdata", "examples", "data-sources", "atlassian_jira_foo") + " [example]",
+		},
+		{
+			TestName: "data source test name",
+			Input:    violations[2],
+			Expect:   dataSource + ": no acceptance test named TestAccJiraFooDataSource_* in " + filepath.Join("testdata", "internal", "provider", "data_source_jira_foo_test.go") + " [test]",
+		},
+		{
+			TestName: "resource import state call",
+			Input:    violations[3],
+			Expect:   importResource + ": method ImportState does not call the importState method of the provider [import-state]",
+		},
+		{
+			TestName: "resource not registered",
+			Input:    violations[4],
+			Expect:   resource + ": NewJiraFooResource is not registered in the Resources method of the provider [registered]",
+		},
+		{
+			TestName: "resource import state",
+			Input:    violations[5],
+			Expect:   resource + ": missing var _ resource.ResourceWithImportState assertion [import-state]",
+		},
+		{
+			TestName: "resource schema version",
+			Input:    violations[6],
+			Expect:   resource + ": schema has no Version [schema-version]",
+		},
+		{
+			TestName: "resource attribute description",
+			Input:    violations[7],
+			Expect:   resource + ": attribute name has no MarkdownDescription [description]",
+		},
+		{
+			TestName: "resource nested attribute description",
+			Input:    violations[8],
+			Expect:   resource + ": attribute holders.type has no MarkdownDescription [description]",
+		},
+		{
+			TestName: "resource test file",
+			Input:    violations[9],
+			Expect:   resource + ": no test file " + filepath.Join("testdata", "internal", "provider", "resource_jira_foo_test.go") + " [test]",
+		},
+	}
+
+	if len(violations) != len(tests) {
+		t.Fatalf("got %d violations, expected %d: %v", len(violations), len(tests), violations)
+	}
+	for _, tt := range tests {
+		t.Run(tt.TestName, func(t *testing.T) {
+			result := tt.Input.String()
+			if result != tt.Expect {
+				t.Errorf("got %s, expected %s", result, tt.Expect)
+			}
+		})
+	}
+}
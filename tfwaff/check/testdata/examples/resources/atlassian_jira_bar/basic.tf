@@ -0,0 +1,3 @@
+resource "atlassian_jira_bar" "example" {
+  name = "foo"
+}
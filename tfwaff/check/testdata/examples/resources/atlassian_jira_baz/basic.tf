@@ -0,0 +1,3 @@
+resource "atlassian_jira_baz" "example" {
+  name = "foo"
+}
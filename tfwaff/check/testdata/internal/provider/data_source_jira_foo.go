@@ -0,0 +1,21 @@
+package atlassian
+
+func NewJiraFooDataSource() datasource.DataSource {
+	return &jiraFooDataSource{}
+}
+
+func (*jiraFooDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_jira_foo"
+}
+
+func (*jiraFooDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Jira Foo Data Source",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the foo.",
+				Required:            true,
+			},
+		},
+	}
+}
@@ -0,0 +1,3 @@
+package atlassian
+
+func TestAccJiraFoo_Basic(t *testing.T) {}
@@ -0,0 +1,12 @@
+package atlassian
+
+func (*atlassianProvider) Resources(ctx context.Context) []func() resource.Resource {
+	return []func() resource.Resource{
+		NewJiraBarResource,
+		NewJiraBazResource,
+	}
+}
+
+func (*atlassianProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
+	return []func() datasource.DataSource{}
+}
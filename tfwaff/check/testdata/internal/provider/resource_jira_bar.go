@@ -0,0 +1,32 @@
+package atlassian
+
+var (
+	_ resource.Resource                = (*jiraBarResource)(nil)
+	_ resource.ResourceWithImportState = (*jiraBarResource)(nil)
+)
+
+func NewJiraBarResource() resource.Resource {
+	return &jiraBarResource{}
+}
+
+func (*jiraBarResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_jira_bar"
+}
+
+func (*jiraBarResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version:             1,
+		MarkdownDescription: "Jira Bar Resource",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the bar.",
+				Required:            true,
+			},
+			"site": resourceSiteAttribute(),
+		},
+	}
+}
+
+func (r *jiraBarResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	r.p.importState(ctx, req, resp, importAttribute{path.Root("id"), true})
+}
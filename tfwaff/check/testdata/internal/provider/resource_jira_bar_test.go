@@ -0,0 +1,3 @@
+package atlassian
+
+func TestAccJiraBar_Basic(t *testing.T) {}
@@ -0,0 +1,32 @@
+package atlassian
+
+var (
+	_ resource.Resource                = (*jiraBazResource)(nil)
+	_ resource.ResourceWithImportState = (*jiraBazResource)(nil)
+)
+
+func NewJiraBazResource() resource.Resource {
+	return &jiraBazResource{}
+}
+
+func (*jiraBazResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_jira_baz"
+}
+
+func (*jiraBazResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version:             1,
+		MarkdownDescription: "Jira Baz Resource",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the baz.",
+				Required:            true,
+			},
+			"site": resourceSiteAttribute(),
+		},
+	}
+}
+
+func (r *jiraBazResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,3 @@
+package atlassian
+
+func TestAccJiraBaz_Basic(t *testing.T) {}
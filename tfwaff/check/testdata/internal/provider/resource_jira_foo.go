@@ -0,0 +1,36 @@
+package atlassian
+
+var (
+	_ resource.Resource = (*jiraFooResource)(nil)
+)
+
+//tfwaff:ignore example the foo example is in the bar example
+func NewJiraFooResource() resource.Resource {
+	return &jiraFooResource{}
+}
+
+func (*jiraFooResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_jira_foo"
+}
+
+func (*jiraFooResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Jira Foo Resource",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required: true,
+			},
+			"holders": schema.ListNestedAttribute{
+				MarkdownDescription: "The holders of the foo.",
+				Optional:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
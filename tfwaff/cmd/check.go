@@ -0,0 +1,36 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/openscientia/terraform-provider-atlassian/tfwaff/check"
+	"github.com/openscientia/terraform-provider-atlassian/tfwaff/utils"
+	"github.com/spf13/cobra"
+)
+
+var checkCmd = &cobra.Command{
+	Use:   "check",
+	Short: "Report the resources and data sources violating the conventions of the provider",
+	Long: `Report the resources and data sources violating the conventions of the provider:
+registered constructors, ImportState and schema Version of resources, a MarkdownDescription on
+every attribute, an example and acceptance tests named TestAcc<Name>_ or TestAcc<Name>DataSource_.
+Exits with a non-zero status when any violation is found.`,
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		root, err := utils.RootDir()
+		if err != nil {
+			return err
+		}
+		violations, err := check.Run(".", root)
+		if err != nil {
+			return err
+		}
+		for _, v := range violations {
+			fmt.Println(v)
+		}
+		if len(violations) > 0 {
+			return fmt.Errorf("found %d convention violations", len(violations))
+		}
+		return nil
+	},
+}
@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"github.com/openscientia/terraform-provider-atlassian/tfwaff/datasource"
+	"github.com/openscientia/terraform-provider-atlassian/tfwaff/goschema"
 	"github.com/spf13/cobra"
 )
 
@@ -9,10 +10,25 @@ var datasourceCmd = &cobra.Command{
 	Use:   "datasource",
 	Short: "Generate all necessary files for a data source",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		return datasource.Create(provider, name, force, dry_run)
+		var schema *goschema.DataSourceSchema
+		if fromResource != "" {
+			var err error
+			schema, err = goschema.Load(fromResource, lookup)
+			if err != nil {
+				return err
+			}
+		}
+		return datasource.Create(provider, name, schema, !no_register, force, dry_run)
 	},
 }
 
+var (
+	fromResource string
+	lookup       []string
+)
+
 func init() {
 	datasourceCmd.Flags().StringVarP(&name, "name", "n", "", "Full name of the new data-source in snake case, e.g. <provider>_<service>_<name>")
+	datasourceCmd.Flags().StringVar(&fromResource, "from-resource", "", "Generate the schema from the schema of an existing resource, given by its Go file, e.g. resource_jira_issue_type_scheme.go")
+	datasourceCmd.Flags().StringSliceVar(&lookup, "lookup", []string{"id", "name"}, "Attributes of the resource identifying the object to read, used with --from-resource")
 }
@@ -1,6 +1,9 @@
 package cmd
 
 import (
+	"fmt"
+
+	"github.com/openscientia/terraform-provider-atlassian/tfwaff/openapi"
 	"github.com/openscientia/terraform-provider-atlassian/tfwaff/resource"
 	"github.com/spf13/cobra"
 )
@@ -9,10 +12,31 @@ var resourceCmd = &cobra.Command{
 	Use:   "resource",
 	Short: "Generate all necessary files for a resource",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		return resource.Create(provider, name, force, dry_run)
+		var schema *openapi.ResourceSchema
+		if fromOpenAPI != "" {
+			if spec == "" {
+				return fmt.Errorf("--spec is required with --from-openapi")
+			}
+			s, err := openapi.Load(spec)
+			if err != nil {
+				return err
+			}
+			schema, err = s.ResourceSchema(fromOpenAPI)
+			if err != nil {
+				return err
+			}
+		}
+		return resource.Create(provider, name, schema, !no_register, force, dry_run)
 	},
 }
 
+var (
+	fromOpenAPI string
+	spec        string
+)
+
 func init() {
 	resourceCmd.Flags().StringVarP(&name, "name", "n", "", "Name of the new resource in pascal case (i.e. MixedMaps) as: <Service><Name>")
+	resourceCmd.Flags().StringVar(&fromOpenAPI, "from-openapi", "", "Generate the schema from an operation of the OpenAPI spec, given by operationId or path, e.g. createProjectCategory or /rest/api/3/projectCategory")
+	resourceCmd.Flags().StringVar(&spec, "spec", "", "Path to a local copy of the Jira or Confluence OpenAPI spec in JSON, used with --from-openapi")
 }
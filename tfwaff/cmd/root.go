@@ -11,6 +11,8 @@ var (
 	name     string
 	force    bool
 	dry_run  bool
+
+	no_register bool
 )
 
 var (
@@ -30,8 +32,10 @@ func Execute() {
 
 func init() {
 	rootCmd.PersistentFlags().BoolVarP(&force, "force", "f", false, "force creation, overwrite existing files")
-	rootCmd.PersistentFlags().BoolVar(&dry_run, "dry-run", false, "do not create or overwrite files")
+	rootCmd.PersistentFlags().BoolVar(&dry_run, "dry-run", false, "do not create or overwrite files, list them and print the changes to provider.go and CHANGELOG.md instead")
+	rootCmd.PersistentFlags().BoolVar(&no_register, "no-register", false, "do not add the constructor to the provider in provider.go")
 
 	rootCmd.AddCommand(resourceCmd)
 	rootCmd.AddCommand(datasourceCmd)
+	rootCmd.AddCommand(checkCmd)
 }
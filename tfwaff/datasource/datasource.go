@@ -3,15 +3,16 @@ package datasource
 import (
 	"bytes"
 	_ "embed"
-	"errors"
 	"fmt"
-	"html/template"
-	"io/fs"
-	"os"
+	"go/format"
+	"path/filepath"
 	"strings"
+	"text/template"
 
+	"github.com/openscientia/terraform-provider-atlassian/tfwaff/changelog"
+	"github.com/openscientia/terraform-provider-atlassian/tfwaff/goschema"
+	"github.com/openscientia/terraform-provider-atlassian/tfwaff/register"
 	"github.com/openscientia/terraform-provider-atlassian/tfwaff/utils"
-	"github.com/spf13/cobra"
 )
 
 //go:embed datasource.tmpl
@@ -20,6 +21,12 @@ var datasourceTmpl string
 //go:embed datasource_test.tmpl
 var datasourceTestTmpl string
 
+//go:embed datasource_example.tmpl
+var datasourceExampleTmpl string
+
+//go:embed datasource_docs.tmpl
+var datasourceDocsTmpl string
+
 type dataSourceTemplateData struct {
 	ProviderLower            string
 	ProviderSuffix           string
@@ -35,9 +42,18 @@ type dataSourceTemplateData struct {
 	DataSourceFilenamePrefix string
 	ServiceLower             string
 	ServiceTitle             string
+	// ServiceClient is the field of the provider holding the API client of the service.
+	ServiceClient string
+	// Schema holds the attributes generated from the schema of a resource, if any.
+	Schema *goschema.DataSourceSchema
 }
 
-func Create(provider, name string, force, dry_run bool) error {
+// Create generates the files of a new data source: its Go and acceptance test files in the working
+// directory, its example configuration in examples/data-sources and its docs template in
+// templates/data-sources, and adds it to CHANGELOG.md. When registerConstructor is set, the
+// constructor of the data source is added to the provider in provider.go. When schema is not nil,
+// the model struct and schema attributes of the data source are generated from it.
+func Create(provider, name string, schema *goschema.DataSourceSchema, registerConstructor, force, dry_run bool) error {
 	if !utils.IsPascalCase(name) {
 		return fmt.Errorf("'name' must be in pascal case, e.g., FooBarBaz")
 	}
@@ -66,55 +82,107 @@ func Create(provider, name string, force, dry_run bool) error {
 		DataSourceFilenamePrefix: "data_source",
 		ServiceLower:             service,
 		ServiceTitle:             serviceTitle,
+		ServiceClient:            utils.GetServiceClient(service),
+		Schema:                   schema,
+	}
+
+	root, err := utils.RootDir()
+	if err != nil {
+		return err
+	}
+
+	templates := []struct {
+		name, filename, tmpl string
+	}{
+		{"new-datasource", fmt.Sprintf("%s_%s_%s.go", dstd.DataSourceFilenamePrefix, dstd.ServiceLower, dstd.DataSourceSnake), datasourceTmpl},
+		{"new-datasource-test-file", fmt.Sprintf("%s_%s_%s_test.go", dstd.DataSourceFilenamePrefix, dstd.ServiceLower, dstd.DataSourceSnake), datasourceTestTmpl},
+		{"new-datasource-example", filepath.Join(root, "examples", "data-sources", dstd.DataSourceSnakeFull, "basic.tf"), datasourceExampleTmpl},
+		{"new-datasource-docs", filepath.Join(root, "templates", "data-sources", fmt.Sprintf("%s_%s.md.tmpl", dstd.ServiceLower, dstd.DataSourceSnake)), datasourceDocsTmpl},
 	}
 
-	if !dry_run {
-		filename := fmt.Sprintf("%s_%s_%s.go", dstd.DataSourceFilenamePrefix, dstd.ServiceLower, dstd.DataSourceSnake)
-		if err := writeTemplate("new-datasource", filename, datasourceTmpl, force, dstd); err != nil {
-			cobra.CheckErr(err)
-			return fmt.Errorf("writing data source template: %w", err)
+	files := make([]utils.File, 0, len(templates))
+	for _, t := range templates {
+		src, err := renderTemplate(t.name, t.filename, t.tmpl, dstd)
+		if err != nil {
+			return fmt.Errorf("rendering %s: %w", t.filename, err)
 		}
+		files = append(files, utils.File{Name: t.filename, Src: src})
+	}
 
-		testFilename := fmt.Sprintf("%s_%s_%s_test.go", dstd.DataSourceFilenamePrefix, dstd.ServiceLower, dstd.DataSourceSnake)
-		if err := writeTemplate("new-resource-test-file", testFilename, datasourceTestTmpl, force, dstd); err != nil {
-			return fmt.Errorf("writing resource test template: %w", err)
+	if err := utils.WriteFiles(files, force, dry_run); err != nil {
+		return fmt.Errorf("writing data source files: %w", err)
+	}
+
+	if registerConstructor {
+		if err := registerDataSource("New"+serviceTitle+dPascal+dstd.DataSourceSuffix, dry_run); err != nil {
+			return err
 		}
 	}
 
+	if err := addChangelogEntry(filepath.Join(root, "CHANGELOG.md"), "* **New Data Source:** `"+dstd.DataSourceSnakeFull+"`", dry_run); err != nil {
+		return err
+	}
+
 	fmt.Println("created new data source:", name)
 
 	return nil
 }
 
-func writeTemplate(templateName, filename, tmpl string, force bool, td dataSourceTemplateData) error {
-	if _, err := os.Stat(filename); !errors.Is(err, fs.ErrNotExist) && !force {
-		return fmt.Errorf("file (%s) already exists and force is not set", filename)
-	}
-
-	f, err := os.OpenFile(filename, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0644)
-	if err != nil {
-		return fmt.Errorf("error opening file (%s): %s", filename, err)
-	}
-
+// renderTemplate executes the template of filename, and formats its output if it is a Go file.
+func renderTemplate(templateName, filename, tmpl string, td dataSourceTemplateData) ([]byte, error) {
 	tplate, err := template.New(templateName).Parse(tmpl)
 	if err != nil {
-		return fmt.Errorf("error parsing template: %s", err)
+		return nil, fmt.Errorf("error parsing template: %s", err)
 	}
 
 	var buffer bytes.Buffer
 	err = tplate.Execute(&buffer, td)
 	if err != nil {
-		return fmt.Errorf("error executing template: %s", err)
+		return nil, fmt.Errorf("error executing template: %s", err)
 	}
 
-	if _, err := f.Write(buffer.Bytes()); err != nil {
-		f.Close() // ignore error; Write error takes precedence
-		return fmt.Errorf("error writing to file (%s): %s", filename, err)
+	if filepath.Ext(filename) != ".go" {
+		return buffer.Bytes(), nil
 	}
 
-	if err := f.Close(); err != nil {
-		return fmt.Errorf("error closing file (%s): %s", filename, err)
+	src, err := format.Source(buffer.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("error formatting template output: %s", err)
+	}
+
+	return src, nil
+}
+
+// registerDataSource adds the constructor to the DataSources of the provider, or prints the change in a dry run.
+func registerDataSource(constructor string, dry_run bool) error {
+	diff, err := register.Constructor("provider.go", "DataSources", constructor, dry_run)
+	if err != nil {
+		return fmt.Errorf("registering data source: %w", err)
+	}
+	switch {
+	case diff == "":
+		fmt.Println("already registered:", constructor)
+	case dry_run:
+		fmt.Print(diff)
+	default:
+		fmt.Println("registered in provider.go:", constructor)
 	}
+	return nil
+}
 
+// addChangelogEntry adds the entry to the unreleased version in CHANGELOG.md, or prints the change in a dry run.
+func addChangelogEntry(filename, entry string, dry_run bool) error {
+	diff, err := changelog.Entry(filename, entry, dry_run)
+	if err != nil {
+		return fmt.Errorf("adding changelog entry: %w", err)
+	}
+	switch {
+	case diff == "":
+		fmt.Println("already in CHANGELOG.md:", entry)
+	case dry_run:
+		fmt.Print(diff)
+	default:
+		fmt.Println("added to CHANGELOG.md:", entry)
+	}
 	return nil
 }
@@ -1,7 +1,10 @@
 package datasource
 
 import (
+	"strings"
 	"testing"
+
+	"github.com/openscientia/terraform-provider-atlassian/tfwaff/goschema"
 )
 
 func TestCreate(t *testing.T) {
@@ -29,7 +32,7 @@ func TestCreate(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.TestName, func(t *testing.T) {
-			result := Create("abc", tt.Input, true, true)
+			result := Create("abc", tt.Input, nil, false, true, true)
 			if result != nil {
 				if result.Error() != tt.Expect {
 					t.Errorf("got %s, expected %s", result.Error(), tt.Expect)
@@ -38,3 +41,37 @@ func TestCreate(t *testing.T) {
 		})
 	}
 }
+
+func TestRenderTemplate_FromResource(t *testing.T) {
+	schema, err := goschema.Load("../goschema/testdata/resource_jira_foo_scheme.go", []string{"id", "name"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	src, err := renderTemplate("new-datasource", "data_source_jira_foo_scheme.go", datasourceTmpl, dataSourceTemplateData{
+		ProviderLower:         "atlassian",
+		ProviderSuffix:        "Provider",
+		DataSourcePascal:      "FooScheme",
+		DataSourceProse:       "foo scheme",
+		DataSourceSuffix:      "DataSource",
+		DataSourceModelSuffix: "DataSourceModel",
+		ServiceLower:          "jira",
+		ServiceClient:         "jira",
+		Schema:                schema,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, expect := range []string{
+		`"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"`,
+		"Screens      *jiraFooSchemeScreensModel `tfsdk:\"screens\"`",
+		"Optional:            true,\n\t\t\t\tComputed:            true,\n\t\t\t\tValidators: []validator.String{\n\t\t\t\t\tstringvalidator.ExactlyOneOf(path.MatchRoot(\"name\")),",
+		"\"default\": schema.StringAttribute{\n\t\t\t\t\t\tMarkdownDescription: \"The ID of the default screen.\",\n\t\t\t\t\t\tComputed:            true,",
+		"// Look up the foo scheme by id or name, exactly one of which is set",
+	} {
+		if !strings.Contains(string(src), expect) {
+			t.Errorf("expected generated data source to contain %s", expect)
+		}
+	}
+}
@@ -0,0 +1,344 @@
+// Package goschema reads the schema and model struct of an existing resource from its Go source,
+// e.g. resource_jira_issue_type_scheme.go, to generate a matching data source.
+package goschema
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// providerAttributes are the attributes added to every data source by the template.
+var providerAttributes = map[string]bool{
+	"site": true,
+}
+
+// lookupTypes are the attribute types supported as lookup attributes, with the package of their
+// validators.
+var lookupTypes = map[string]string{
+	"String":  "stringvalidator",
+	"Int64":   "int64validator",
+	"Float64": "float64validator",
+	"Bool":    "boolvalidator",
+}
+
+// DataSourceSchema holds the attributes of a data source generated from the schema of a resource.
+type DataSourceSchema struct {
+	// Resource is the name of the Go file of the resource.
+	Resource   string
+	Fields     []Field
+	Attributes []Attribute
+	// Lookups are the names of the lookup attributes.
+	Lookups []string
+	// Unsupported are the attributes of the resource defined by a function call, e.g.
+	// deletionProtectionAttribute("group"), which are not copied to the data source.
+	Unsupported []string
+}
+
+// Field is a field of the model struct.
+type Field struct {
+	Name string
+	// Type is the Go type of the field, e.g. types.String.
+	Type string
+	// Tag is the tag of the field, e.g. tfsdk:"name".
+	Tag string
+}
+
+// Attribute is a schema attribute of a data source.
+type Attribute struct {
+	Name string
+	// Type is the type of the attribute, e.g. String or SingleNested.
+	Type string
+	// Description is the Go expression of the description of the attribute.
+	Description string
+	// ElementType is the Go expression of the element type of collection attributes.
+	ElementType string
+	// Lookup is set for the attributes identifying the object to read, which are Optional with
+	// an ExactlyOneOf validator, or Required when there is a single lookup attribute. The other
+	// attributes are Computed.
+	Lookup bool
+	// OtherLookups are the names of the other lookup attributes of a lookup attribute.
+	OtherLookups []string
+	// Attributes are the nested attributes of nested attributes.
+	Attributes []Attribute
+}
+
+// Load reads the schema of the resource defined in filename, and returns the schema of a data
+// source with the given lookup attributes.
+func Load(filename string, lookups []string) (*DataSourceSchema, error) {
+	src, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, fmt.Errorf("reading resource file: %w", err)
+	}
+	ds, err := Parse(src, lookups)
+	if err != nil {
+		return nil, fmt.Errorf("parsing resource file (%s): %w", filename, err)
+	}
+	ds.Resource = filename
+	return ds, nil
+}
+
+// Parse reads the schema of the resource defined in src, and returns the schema of a data source
+// with the given lookup attributes. Lookup attributes missing from the resource are ignored, but
+// at least one of them must exist.
+func Parse(src []byte, lookups []string) (*DataSourceSchema, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "", src, 0)
+	if err != nil {
+		return nil, err
+	}
+	text := func(n ast.Node) string {
+		return string(src[fset.Position(n.Pos()).Offset:fset.Position(n.End()).Offset])
+	}
+
+	attrs, err := schemaAttributes(file)
+	if err != nil {
+		return nil, err
+	}
+
+	ds := &DataSourceSchema{}
+	names := map[string]bool{}
+	for _, elt := range attrs.Elts {
+		name, value, err := keyValue(elt)
+		if err != nil {
+			return nil, err
+		}
+		if providerAttributes[name] {
+			continue
+		}
+		if _, ok := value.(*ast.CallExpr); ok {
+			ds.Unsupported = append(ds.Unsupported, fmt.Sprintf("%s (%s)", name, text(value)))
+			continue
+		}
+		a, err := attribute(name, value, text)
+		if err != nil {
+			return nil, err
+		}
+		ds.Attributes = append(ds.Attributes, a)
+		names[name] = true
+	}
+
+	for _, l := range lookups {
+		if names[l] {
+			ds.Lookups = append(ds.Lookups, l)
+		}
+	}
+	if len(ds.Lookups) == 0 {
+		return nil, fmt.Errorf("none of the lookup attributes (%s) found in the resource schema", strings.Join(lookups, ", "))
+	}
+	for i, a := range ds.Attributes {
+		if !contains(ds.Lookups, a.Name) {
+			continue
+		}
+		if _, ok := lookupTypes[a.Type]; !ok {
+			return nil, fmt.Errorf("lookup attribute %s has unsupported type %s", a.Name, a.Type)
+		}
+		ds.Attributes[i].Lookup = true
+		for _, l := range ds.Lookups {
+			if l != a.Name {
+				ds.Attributes[i].OtherLookups = append(ds.Attributes[i].OtherLookups, l)
+			}
+		}
+	}
+
+	ds.Fields, err = modelFields(file, names, text)
+	if err != nil {
+		return nil, err
+	}
+
+	return ds, nil
+}
+
+// schemaAttributes returns the map literal of the attributes in the Schema method of the resource.
+func schemaAttributes(file *ast.File) (*ast.CompositeLit, error) {
+	for _, decl := range file.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Recv == nil || fn.Name.Name != "Schema" || fn.Body == nil {
+			continue
+		}
+		var attrs *ast.CompositeLit
+		ast.Inspect(fn.Body, func(n ast.Node) bool {
+			lit, ok := n.(*ast.CompositeLit)
+			if !ok || attrs != nil || !isSelector(lit.Type, "schema", "Schema") {
+				return attrs == nil
+			}
+			attrs, _ = field(lit, "Attributes").(*ast.CompositeLit)
+			return false
+		})
+		if attrs == nil {
+			return nil, fmt.Errorf("method Schema does not set the attributes of a schema.Schema")
+		}
+		return attrs, nil
+	}
+	return nil, fmt.Errorf("method Schema not found")
+}
+
+// attribute returns the data source attribute of a resource attribute, e.g. schema.StringAttribute{...}.
+func attribute(name string, value ast.Expr, text func(ast.Node) string) (Attribute, error) {
+	lit, ok := value.(*ast.CompositeLit)
+	if !ok {
+		return Attribute{}, fmt.Errorf("attribute %s: unexpected value %s", name, text(value))
+	}
+	sel, ok := lit.Type.(*ast.SelectorExpr)
+	if !ok || !strings.HasSuffix(sel.Sel.Name, "Attribute") {
+		return Attribute{}, fmt.Errorf("attribute %s: unexpected type %s", name, text(lit.Type))
+	}
+
+	a := Attribute{
+		Name:        name,
+		Type:        strings.TrimSuffix(sel.Sel.Name, "Attribute"),
+		Description: `""`,
+	}
+	if d := field(lit, "MarkdownDescription"); d != nil {
+		a.Description = text(d)
+	} else if d := field(lit, "Description"); d != nil {
+		a.Description = text(d)
+	}
+	if e := field(lit, "ElementType"); e != nil {
+		a.ElementType = text(e)
+	}
+
+	nested := field(lit, "Attributes")
+	if obj, ok := field(lit, "NestedObject").(*ast.CompositeLit); ok {
+		nested = field(obj, "Attributes")
+	}
+	if nested, ok := nested.(*ast.CompositeLit); ok {
+		for _, elt := range nested.Elts {
+			n, v, err := keyValue(elt)
+			if err != nil {
+				return Attribute{}, err
+			}
+			na, err := attribute(n, v, text)
+			if err != nil {
+				return Attribute{}, fmt.Errorf("attribute %s: %w", name, err)
+			}
+			a.Attributes = append(a.Attributes, na)
+		}
+	}
+
+	return a, nil
+}
+
+// modelFields returns the fields of the model struct of the resource, i.e. the struct type whose
+// name ends with ResourceModel, which match the given attributes.
+func modelFields(file *ast.File, attributes map[string]bool, text func(ast.Node) string) ([]Field, error) {
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.TYPE {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			ts := spec.(*ast.TypeSpec)
+			st, ok := ts.Type.(*ast.StructType)
+			if !ok || !strings.HasSuffix(ts.Name.Name, "ResourceModel") {
+				continue
+			}
+			var fields []Field
+			for _, f := range st.Fields.List {
+				if f.Tag == nil || len(f.Names) != 1 {
+					continue
+				}
+				tag, err := strconv.Unquote(f.Tag.Value)
+				if err != nil {
+					return nil, err
+				}
+				if !attributes[reflect.StructTag(tag).Get("tfsdk")] {
+					continue
+				}
+				fields = append(fields, Field{Name: f.Names[0].Name, Type: text(f.Type), Tag: tag})
+			}
+			return fields, nil
+		}
+	}
+	return nil, fmt.Errorf("resource model struct not found")
+}
+
+// keyValue returns the name and value of an element of a map literal of attributes.
+func keyValue(elt ast.Expr) (string, ast.Expr, error) {
+	kv, ok := elt.(*ast.KeyValueExpr)
+	if !ok {
+		return "", nil, fmt.Errorf("unexpected element %T in attributes", elt)
+	}
+	key, ok := kv.Key.(*ast.BasicLit)
+	if !ok || key.Kind != token.STRING {
+		return "", nil, fmt.Errorf("attribute names must be string literals")
+	}
+	name, err := strconv.Unquote(key.Value)
+	if err != nil {
+		return "", nil, err
+	}
+	return name, kv.Value, nil
+}
+
+// field returns the value of the field with the given name in a struct literal, if any.
+func field(lit *ast.CompositeLit, name string) ast.Expr {
+	for _, elt := range lit.Elts {
+		kv, ok := elt.(*ast.KeyValueExpr)
+		if !ok {
+			continue
+		}
+		if ident, ok := kv.Key.(*ast.Ident); ok && ident.Name == name {
+			return kv.Value
+		}
+	}
+	return nil
+}
+
+func isSelector(expr ast.Expr, pkg, name string) bool {
+	sel, ok := expr.(*ast.SelectorExpr)
+	if !ok {
+		return false
+	}
+	ident, ok := sel.X.(*ast.Ident)
+	return ok && ident.Name == pkg && sel.Sel.Name == name
+}
+
+func contains(list []string, s string) bool {
+	for _, l := range list {
+		if l == s {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidatorPackages returns the packages of the validators of the lookup attributes, if any.
+func (ds *DataSourceSchema) ValidatorPackages() []string {
+	if len(ds.Lookups) < 2 {
+		return nil
+	}
+	seen := map[string]bool{}
+	var pkgs []string
+	for _, a := range ds.Attributes {
+		if pkg := lookupTypes[a.Type]; a.Lookup && !seen[pkg] {
+			seen[pkg] = true
+			pkgs = append(pkgs, pkg)
+		}
+	}
+	return pkgs
+}
+
+// ValidatorPackage returns the package of the validators of the attribute, e.g. stringvalidator.
+func (a Attribute) ValidatorPackage() string {
+	return lookupTypes[a.Type]
+}
+
+// ExactlyOneOf returns the arguments of the ExactlyOneOf validator of a lookup attribute.
+func (a Attribute) ExactlyOneOf() string {
+	paths := make([]string, 0, len(a.OtherLookups))
+	for _, l := range a.OtherLookups {
+		paths = append(paths, fmt.Sprintf("path.MatchRoot(%q)", l))
+	}
+	return strings.Join(paths, ", ")
+}
+
+// IsObject reports whether the nested attributes of the attribute are given by its NestedObject,
+// e.g. for ListNested attributes, rather than directly by its Attributes.
+func (a Attribute) IsObject() bool {
+	return a.Type != "SingleNested"
+}
@@ -0,0 +1,85 @@
+package goschema
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestLoad(t *testing.T) {
+	ds, err := Load("testdata/resource_jira_foo_scheme.go", []string{"id", "name"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expectFields := []Field{
+		{Name: "ID", Type: "types.String", Tag: `tfsdk:"id"`},
+		{Name: "Name", Type: "types.String", Tag: `tfsdk:"name"`},
+		{Name: "IssueTypeIds", Type: "types.List", Tag: `tfsdk:"issue_type_ids"`},
+		{Name: "Screens", Type: "*jiraFooSchemeScreensModel", Tag: `tfsdk:"screens"`},
+	}
+	if !reflect.DeepEqual(ds.Fields, expectFields) {
+		t.Errorf("got fields\n%+v\nexpected\n%+v", ds.Fields, expectFields)
+	}
+
+	expectAttributes := []Attribute{
+		{Name: "id", Type: "String", Description: `"The ID of the foo scheme."`, Lookup: true, OtherLookups: []string{"name"}},
+		{Name: "name", Type: "String", Description: "\"The name of the foo scheme. \" +\n\t\t\t\t\t\"The maximum length is 255 characters.\"", Lookup: true, OtherLookups: []string{"id"}},
+		{Name: "issue_type_ids", Type: "List", Description: `"The IDs of the issue types."`, ElementType: "types.StringType"},
+		{Name: "screens", Type: "SingleNested", Description: `"The screens."`, Attributes: []Attribute{
+			{Name: "default", Type: "String", Description: `"The ID of the default screen."`},
+		}},
+	}
+	if !reflect.DeepEqual(ds.Attributes, expectAttributes) {
+		t.Errorf("got attributes\n%+v\nexpected\n%+v", ds.Attributes, expectAttributes)
+	}
+
+	if !reflect.DeepEqual(ds.Unsupported, []string{`deletion_protection (deletionProtectionAttribute("foo scheme"))`}) {
+		t.Errorf("got unsupported %v", ds.Unsupported)
+	}
+	if !reflect.DeepEqual(ds.ValidatorPackages(), []string{"stringvalidator"}) {
+		t.Errorf("got validator packages %v", ds.ValidatorPackages())
+	}
+	if got := ds.Attributes[0].ExactlyOneOf(); got != `path.MatchRoot("name")` {
+		t.Errorf("got ExactlyOneOf arguments %s", got)
+	}
+}
+
+func TestLoad_Lookups(t *testing.T) {
+	tests := []struct {
+		TestName string
+		Input    []string
+		Expect   string
+	}{
+		{
+			TestName: "single lookup",
+			Input:    []string{"id", "key"},
+			Expect:   "[id]",
+		},
+		{
+			TestName: "no lookup",
+			Input:    []string{"key"},
+			Expect:   "parsing resource file (testdata/resource_jira_foo_scheme.go): none of the lookup attributes (key) found in the resource schema",
+		},
+		{
+			TestName: "unsupported lookup type",
+			Input:    []string{"screens"},
+			Expect:   "parsing resource file (testdata/resource_jira_foo_scheme.go): lookup attribute screens has unsupported type SingleNested",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.TestName, func(t *testing.T) {
+			var result string
+			ds, err := Load("testdata/resource_jira_foo_scheme.go", tt.Input)
+			if err != nil {
+				result = err.Error()
+			} else {
+				result = fmt.Sprint(ds.Lookups)
+			}
+			if result != tt.Expect {
+				t.Errorf("got %s, expected %s", result, tt.Expect)
+			}
+		})
+	}
+}
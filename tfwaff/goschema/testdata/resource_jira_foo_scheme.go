@@ -0,0 +1,65 @@
+package atlassian
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type (
+	jiraFooSchemeResource struct {
+		p atlassianProvider
+	}
+
+	jiraFooSchemeResourceModel struct {
+		ID           types.String               `tfsdk:"id"`
+		Name         types.String               `tfsdk:"name"`
+		IssueTypeIds types.List                 `tfsdk:"issue_type_ids"`
+		Screens      *jiraFooSchemeScreensModel `tfsdk:"screens"`
+
+		DeletionProtection types.Bool `tfsdk:"deletion_protection"`
+
+		Site types.String `tfsdk:"site"`
+	}
+
+	jiraFooSchemeScreensModel struct {
+		Default types.String `tfsdk:"default"`
+	}
+)
+
+func (*jiraFooSchemeResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version:             1,
+		MarkdownDescription: "Jira Foo Scheme Resource",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the foo scheme.",
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the foo scheme. " +
+					"The maximum length is 255 characters.",
+				Required: true,
+			},
+			"issue_type_ids": schema.ListAttribute{
+				Description: "The IDs of the issue types.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"screens": schema.SingleNestedAttribute{
+				MarkdownDescription: "The screens.",
+				Required:            true,
+				Attributes: map[string]schema.Attribute{
+					"default": schema.StringAttribute{
+						MarkdownDescription: "The ID of the default screen.",
+						Required:            true,
+					},
+				},
+			},
+			"deletion_protection": deletionProtectionAttribute("foo scheme"),
+			"site":                resourceSiteAttribute(),
+		},
+	}
+}
@@ -0,0 +1,455 @@
+// Package openapi reads the attributes of resources from a local copy of the OpenAPI spec of the
+// Atlassian REST APIs, e.g. https://developer.atlassian.com/cloud/jira/platform/swagger-v3.v3.json.
+// Both OpenAPI 3 and Swagger 2 specs in JSON are supported.
+package openapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/openscientia/terraform-provider-atlassian/tfwaff/utils"
+)
+
+// maxRefDepth is the maximum number of nested references resolved in a schema, which guards
+// against recursive schemas.
+const maxRefDepth = 32
+
+// methods are the HTTP methods of the operations of a path, in order of preference when an
+// operation is selected by path: the operation creating the object describes its attributes best.
+var methods = []string{"post", "put", "patch", "get"}
+
+type (
+	Spec struct {
+		Paths       map[string]map[string]json.RawMessage `json:"paths"`
+		Definitions map[string]*Schema                    `json:"definitions"`
+		Components  struct {
+			Schemas map[string]*Schema `json:"schemas"`
+		} `json:"components"`
+	}
+
+	Operation struct {
+		OperationID string                     `json:"operationId"`
+		Parameters  []*Parameter               `json:"parameters"`
+		RequestBody *Body                      `json:"requestBody"`
+		Responses   map[string]*ResponseObject `json:"responses"`
+	}
+
+	Parameter struct {
+		Name   string  `json:"name"`
+		In     string  `json:"in"`
+		Schema *Schema `json:"schema"`
+	}
+
+	Body struct {
+		Content map[string]*MediaType `json:"content"`
+	}
+
+	ResponseObject struct {
+		Content map[string]*MediaType `json:"content"`
+		// Schema is the schema of the response of Swagger 2 specs.
+		Schema *Schema `json:"schema"`
+	}
+
+	MediaType struct {
+		Schema *Schema `json:"schema"`
+	}
+
+	Schema struct {
+		Ref         string             `json:"$ref"`
+		Type        string             `json:"type"`
+		Description string             `json:"description"`
+		Properties  map[string]*Schema `json:"properties"`
+		Required    []string           `json:"required"`
+		Enum        []interface{}      `json:"enum"`
+		Items       *Schema            `json:"items"`
+		AllOf       []*Schema          `json:"allOf"`
+		ReadOnly    bool               `json:"readOnly"`
+	}
+)
+
+// ResourceSchema holds the attributes of a resource generated from an operation of the spec.
+type ResourceSchema struct {
+	// Operation describes the operation, e.g. "POST /rest/api/3/projectCategory (createProjectCategory)".
+	Operation string
+	// Attributes are sorted by name, and exclude the `id` attribute, which every resource has.
+	Attributes []Attribute
+	// Unsupported lists the properties whose type has no matching attribute type, e.g. objects,
+	// which must be added by hand.
+	Unsupported []string
+}
+
+// Attribute is a schema attribute of a resource.
+type Attribute struct {
+	// Name is the name of the attribute in snake case.
+	Name string
+	// FieldName is the name of the field of the attribute in the model struct.
+	FieldName string
+	// Type is the attribute type: String, Int64, Float64, Bool or List.
+	Type string
+	// ElementType is the type of the elements of List attributes.
+	ElementType string
+	Description string
+	Required    bool
+	Optional    bool
+	Computed    bool
+	// Enum holds the allowed values of String attributes.
+	Enum []string
+}
+
+// Load reads the OpenAPI spec in JSON from filename.
+func Load(filename string) (*Spec, error) {
+	b, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, fmt.Errorf("reading OpenAPI spec: %w", err)
+	}
+	var s Spec
+	if err := json.Unmarshal(b, &s); err != nil {
+		return nil, fmt.Errorf("parsing OpenAPI spec (%s): %w", filename, err)
+	}
+	return &s, nil
+}
+
+// Operation returns the operation with the given operationId, e.g. createProjectCategory, or
+// path, e.g. /rest/api/3/projectCategory. The operations of a path are preferred in the order
+// POST, PUT, PATCH, GET.
+func (s *Spec) Operation(ref string) (method, path string, op *Operation, err error) {
+	if strings.HasPrefix(ref, "/") {
+		item, ok := s.Paths[ref]
+		if !ok {
+			return "", "", nil, fmt.Errorf("path (%s) not found in OpenAPI spec", ref)
+		}
+		for _, m := range methods {
+			if raw, ok := item[m]; ok {
+				op, err := parseOperation(raw)
+				return m, ref, op, err
+			}
+		}
+		return "", "", nil, fmt.Errorf("path (%s) has no %s operation", ref, strings.ToUpper(strings.Join(methods, ", ")))
+	}
+
+	paths := make([]string, 0, len(s.Paths))
+	for p := range s.Paths {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+	for _, p := range paths {
+		for _, m := range methods {
+			raw, ok := s.Paths[p][m]
+			if !ok {
+				continue
+			}
+			op, err := parseOperation(raw)
+			if err != nil {
+				return "", "", nil, err
+			}
+			if op.OperationID == ref {
+				return m, p, op, nil
+			}
+		}
+	}
+	return "", "", nil, fmt.Errorf("operationId (%s) not found in OpenAPI spec", ref)
+}
+
+func parseOperation(raw json.RawMessage) (*Operation, error) {
+	var op Operation
+	if err := json.Unmarshal(raw, &op); err != nil {
+		return nil, fmt.Errorf("parsing operation: %w", err)
+	}
+	return &op, nil
+}
+
+// ResourceSchema returns the attributes of a resource generated from the operation with the
+// given operationId or path, see Operation. Properties of the request body are Required or
+// Optional, following the required properties of the request, and properties only returned in the
+// response are Computed. Optional properties also returned in the response are Computed too.
+func (s *Spec) ResourceSchema(ref string) (*ResourceSchema, error) {
+	method, path, op, err := s.Operation(ref)
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := s.object(op.requestSchema(), 0)
+	if err != nil {
+		return nil, fmt.Errorf("resolving request schema: %w", err)
+	}
+	response, err := s.object(op.responseSchema(), 0)
+	if err != nil {
+		return nil, fmt.Errorf("resolving response schema: %w", err)
+	}
+
+	names := map[string]bool{}
+	for n := range request.Properties {
+		names[n] = true
+	}
+	for n := range response.Properties {
+		names[n] = true
+	}
+	required := map[string]bool{}
+	for _, n := range request.Required {
+		required[n] = true
+	}
+
+	rs := &ResourceSchema{
+		Operation: fmt.Sprintf("%s %s", strings.ToUpper(method), path),
+	}
+	if op.OperationID != "" {
+		rs.Operation += fmt.Sprintf(" (%s)", op.OperationID)
+	}
+
+	for n := range names {
+		if n == "id" {
+			continue
+		}
+		reqProp, inRequest := request.Properties[n]
+		resProp, inResponse := response.Properties[n]
+		prop := resProp
+		if inRequest {
+			prop = reqProp
+		}
+		prop, err = s.resolve(prop, 0)
+		if err != nil {
+			return nil, fmt.Errorf("resolving property (%s): %w", n, err)
+		}
+
+		a := Attribute{
+			Name:        utils.GetSnakeCaseFromCamelCase(n),
+			Description: description(prop, resProp, n),
+		}
+		a.FieldName = utils.GetPascalCaseFromSnakeCase(a.Name)
+
+		switch {
+		case inRequest && !prop.ReadOnly && required[n]:
+			a.Required = true
+		case inRequest && !prop.ReadOnly:
+			a.Optional = true
+			a.Computed = inResponse
+		default:
+			a.Computed = true
+		}
+
+		if !s.setType(&a, prop) {
+			kind := prop.Type
+			if kind == "" {
+				kind = "unknown type"
+			}
+			rs.Unsupported = append(rs.Unsupported, fmt.Sprintf("%s (%s)", n, kind))
+			continue
+		}
+		rs.Attributes = append(rs.Attributes, a)
+	}
+
+	sort.Slice(rs.Attributes, func(i, j int) bool { return rs.Attributes[i].Name < rs.Attributes[j].Name })
+	sort.Strings(rs.Unsupported)
+	return rs, nil
+}
+
+// HasEnums reports whether any attribute has an enum validator.
+func (rs *ResourceSchema) HasEnums() bool {
+	for _, a := range rs.Attributes {
+		if len(a.Enum) != 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// ExampleArguments returns the Required attributes with example values as arguments of a
+// Terraform block, aligned as by terraform fmt, e.g. `name = "foo"`.
+func (rs *ResourceSchema) ExampleArguments() []string {
+	width := 0
+	for _, a := range rs.Attributes {
+		if a.Required && len(a.Name) > width {
+			width = len(a.Name)
+		}
+	}
+	var args []string
+	for _, a := range rs.Attributes {
+		if a.Required {
+			args = append(args, fmt.Sprintf("%-*s = %s", width, a.Name, a.ExampleValue()))
+		}
+	}
+	return args
+}
+
+// requestSchema returns the schema of the JSON request body of the operation.
+func (op *Operation) requestSchema() *Schema {
+	if op.RequestBody != nil {
+		if mt, ok := op.RequestBody.Content["application/json"]; ok {
+			return mt.Schema
+		}
+	}
+	for _, p := range op.Parameters {
+		if p.In == "body" {
+			return p.Schema
+		}
+	}
+	return nil
+}
+
+// responseSchema returns the schema of the JSON body of the successful response of the operation.
+func (op *Operation) responseSchema() *Schema {
+	for _, code := range []string{"200", "201", "202"} {
+		res, ok := op.Responses[code]
+		if !ok {
+			continue
+		}
+		if mt, ok := res.Content["application/json"]; ok {
+			return mt.Schema
+		}
+		if res.Schema != nil {
+			return res.Schema
+		}
+	}
+	return nil
+}
+
+// resolve returns the schema referenced by schema, if any.
+func (s *Spec) resolve(schema *Schema, depth int) (*Schema, error) {
+	if schema == nil || schema.Ref == "" {
+		return schema, nil
+	}
+	if depth > maxRefDepth {
+		return nil, fmt.Errorf("too many nested references (%s)", schema.Ref)
+	}
+
+	var target *Schema
+	switch name := schema.Ref[strings.LastIndex(schema.Ref, "/")+1:]; {
+	case strings.HasPrefix(schema.Ref, "#/components/schemas/"):
+		target = s.Components.Schemas[name]
+	case strings.HasPrefix(schema.Ref, "#/definitions/"):
+		target = s.Definitions[name]
+	}
+	if target == nil {
+		return nil, fmt.Errorf("reference (%s) not found", schema.Ref)
+	}
+	return s.resolve(target, depth+1)
+}
+
+// object returns schema with its references resolved and its allOf schemas merged. It returns
+// an empty schema for a nil schema.
+func (s *Spec) object(schema *Schema, depth int) (*Schema, error) {
+	schema, err := s.resolve(schema, depth)
+	if err != nil {
+		return nil, err
+	}
+	merged := &Schema{Properties: map[string]*Schema{}}
+	if schema == nil {
+		return merged, nil
+	}
+
+	for _, sub := range schema.AllOf {
+		obj, err := s.object(sub, depth+1)
+		if err != nil {
+			return nil, err
+		}
+		for n, p := range obj.Properties {
+			merged.Properties[n] = p
+		}
+		merged.Required = append(merged.Required, obj.Required...)
+	}
+	for n, p := range schema.Properties {
+		merged.Properties[n] = p
+	}
+	merged.Required = append(merged.Required, schema.Required...)
+	return merged, nil
+}
+
+// setType sets the type of the attribute from the type of the property, and reports whether the
+// type is supported.
+func (s *Spec) setType(a *Attribute, prop *Schema) bool {
+	switch prop.Type {
+	case "array":
+		items, err := s.resolve(prop.Items, 0)
+		if err != nil || items == nil {
+			return false
+		}
+		elem := scalarType(items.Type)
+		if elem == "" {
+			return false
+		}
+		a.Type = "List"
+		a.ElementType = elem
+	default:
+		a.Type = scalarType(prop.Type)
+		if a.Type == "" {
+			return false
+		}
+		if a.Type == "String" {
+			for _, v := range prop.Enum {
+				if str, ok := v.(string); ok {
+					a.Enum = append(a.Enum, str)
+				}
+			}
+		}
+	}
+	return true
+}
+
+func scalarType(t string) string {
+	switch t {
+	case "string":
+		return "String"
+	case "integer":
+		return "Int64"
+	case "number":
+		return "Float64"
+	case "boolean":
+		return "Bool"
+	}
+	return ""
+}
+
+// description returns the description of a property, falling back to the description in the
+// response, and to its name.
+func description(prop, resProp *Schema, name string) string {
+	d := prop.Description
+	if d == "" && resProp != nil {
+		d = resProp.Description
+	}
+	d = strings.TrimSpace(regexp.MustCompile(`\s+`).ReplaceAllString(d, " "))
+	if d == "" {
+		d = fmt.Sprintf("The %s.", strings.ReplaceAll(utils.GetSnakeCaseFromCamelCase(name), "_", " "))
+	}
+	return d
+}
+
+// GoType returns the type of the field of the attribute in the model struct.
+func (a Attribute) GoType() string {
+	return "types." + a.Type
+}
+
+// ElementTypeValue returns the element type of List attributes, e.g. types.StringType.
+func (a Attribute) ElementTypeValue() string {
+	return "types." + a.ElementType + "Type"
+}
+
+// EnumValues returns the allowed values of the attribute as Go string literals.
+func (a Attribute) EnumValues() string {
+	quoted := make([]string, 0, len(a.Enum))
+	for _, v := range a.Enum {
+		quoted = append(quoted, fmt.Sprintf("%q", v))
+	}
+	return strings.Join(quoted, ", ")
+}
+
+// ExampleValue returns a value of the attribute in Terraform configuration, used in the generated
+// examples.
+func (a Attribute) ExampleValue() string {
+	switch a.Type {
+	case "String":
+		if len(a.Enum) > 0 {
+			return fmt.Sprintf("%q", a.Enum[0])
+		}
+		return `"foo"`
+	case "Bool":
+		return "true"
+	case "List":
+		return "[]"
+	default:
+		return "1"
+	}
+}
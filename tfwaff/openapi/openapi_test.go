@@ -0,0 +1,132 @@
+package openapi
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestOperation(t *testing.T) {
+	s, err := Load("testdata/openapi3.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		TestName string
+		Input    string
+		Expect   string
+	}{
+		{
+			TestName: "operationId",
+			Input:    "getIssueAllTypes",
+			Expect:   "get /rest/api/3/issuetype",
+		},
+		{
+			TestName: "path prefers post",
+			Input:    "/rest/api/3/issuetype",
+			Expect:   "post /rest/api/3/issuetype",
+		},
+		{
+			TestName: "unknown operationId",
+			Input:    "createFoo",
+			Expect:   "operationId (createFoo) not found in OpenAPI spec",
+		},
+		{
+			TestName: "unknown path",
+			Input:    "/rest/api/3/foo",
+			Expect:   "path (/rest/api/3/foo) not found in OpenAPI spec",
+		},
+		{
+			TestName: "path without supported operation",
+			Input:    "/rest/api/3/issuetype/{id}",
+			Expect:   "path (/rest/api/3/issuetype/{id}) has no POST, PUT, PATCH, GET operation",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.TestName, func(t *testing.T) {
+			method, path, _, err := s.Operation(tt.Input)
+			result := method + " " + path
+			if err != nil {
+				result = err.Error()
+			}
+			if result != tt.Expect {
+				t.Errorf("got %s, expected %s", result, tt.Expect)
+			}
+		})
+	}
+}
+
+func TestResourceSchema(t *testing.T) {
+	s, err := Load("testdata/openapi3.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rs, err := s.ResourceSchema("createIssueType")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if rs.Operation != "POST /rest/api/3/issuetype (createIssueType)" {
+		t.Errorf("got operation %s", rs.Operation)
+	}
+
+	expect := []Attribute{
+		{Name: "description", FieldName: "Description", Type: "String", Description: "The description of the issue type.", Optional: true, Computed: true},
+		{Name: "entity_ids", FieldName: "EntityIds", Type: "List", ElementType: "String", Description: "The entity ids.", Computed: true},
+		{Name: "hierarchy_level", FieldName: "HierarchyLevel", Type: "Int64", Description: "The hierarchy level.", Optional: true, Computed: true},
+		{Name: "icon_url", FieldName: "IconUrl", Type: "String", Description: "The URL of the issue type's avatar.", Computed: true},
+		{Name: "name", FieldName: "Name", Type: "String", Description: "The unique name for the issue type. The maximum length is 60 characters.", Required: true},
+		{Name: "self", FieldName: "Self", Type: "String", Description: "The URL of these issue type details.", Computed: true},
+		{Name: "subtask", FieldName: "Subtask", Type: "Bool", Description: "Whether this issue type is used to create subtasks.", Computed: true},
+		{Name: "type", FieldName: "Type", Type: "String", Description: "Deprecated. Use `hierarchyLevel` instead.", Optional: true, Enum: []string{"subtask", "standard"}},
+	}
+	if !reflect.DeepEqual(rs.Attributes, expect) {
+		t.Errorf("got attributes\n%+v\nexpected\n%+v", rs.Attributes, expect)
+	}
+
+	if !reflect.DeepEqual(rs.Unsupported, []string{"scope (object)"}) {
+		t.Errorf("got unsupported %v", rs.Unsupported)
+	}
+
+	if !rs.HasEnums() {
+		t.Errorf("expected enums")
+	}
+	if got := rs.Attributes[7].EnumValues(); got != `"subtask", "standard"` {
+		t.Errorf("got enum values %s", got)
+	}
+
+	for i, expect := range map[int]string{1: "[]", 2: "1", 4: `"foo"`, 6: "true", 7: `"subtask"`} {
+		if got := rs.Attributes[i].ExampleValue(); got != expect {
+			t.Errorf("got example value %s of %s, expected %s", got, rs.Attributes[i].Name, expect)
+		}
+	}
+}
+
+func TestResourceSchema_Swagger2(t *testing.T) {
+	s, err := Load("testdata/swagger2.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rs, err := s.ResourceSchema("/wiki/rest/api/space")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expect := []Attribute{
+		{Name: "key", FieldName: "Key", Type: "String", Description: "The key for the new space.", Required: true},
+		{Name: "name", FieldName: "Name", Type: "String", Description: "The name of the new space.", Required: true},
+		{Name: "status", FieldName: "Status", Type: "String", Description: "The status.", Computed: true},
+	}
+	if !reflect.DeepEqual(rs.Attributes, expect) {
+		t.Errorf("got attributes\n%+v\nexpected\n%+v", rs.Attributes, expect)
+	}
+	if rs.HasEnums() {
+		t.Errorf("expected no enums")
+	}
+	if got := rs.ExampleArguments(); !reflect.DeepEqual(got, []string{`key  = "foo"`, `name = "foo"`}) {
+		t.Errorf("got example arguments %v", got)
+	}
+}
@@ -0,0 +1,206 @@
+// Package register adds the constructors of new resources and data sources to the provider,
+// i.e. to the lists returned by the Resources and DataSources methods in provider.go.
+package register
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"os"
+	"sort"
+	"strings"
+)
+
+// Constructor adds the constructor to the list returned by the given method, e.g. Resources, of
+// the provider in filename, keeping the list sorted. Unless dryRun is set, filename is rewritten.
+// It returns the diff of the change, which is empty when the constructor is already registered.
+func Constructor(filename, method, constructor string, dryRun bool) (string, error) {
+	src, err := os.ReadFile(filename)
+	if err != nil {
+		return "", fmt.Errorf("reading provider file: %w", err)
+	}
+
+	out, err := Insert(src, method, constructor)
+	if err != nil {
+		return "", fmt.Errorf("registering %s in %s: %w", constructor, filename, err)
+	}
+	if bytes.Equal(src, out) {
+		return "", nil
+	}
+
+	if !dryRun {
+		info, err := os.Stat(filename)
+		if err != nil {
+			return "", err
+		}
+		if err := os.WriteFile(filename, out, info.Mode()); err != nil {
+			return "", fmt.Errorf("writing provider file: %w", err)
+		}
+	}
+
+	return Diff(filename, src, out), nil
+}
+
+// Insert returns src with the constructor added to the list returned by the given method. The
+// list is located in the syntax tree of src, and the constructor is inserted on its own line
+// before the first element sorting after it and the comments above that element, or after the
+// last element.
+func Insert(src []byte, method, constructor string) ([]byte, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "", src, parser.ParseComments)
+	if err != nil {
+		return nil, fmt.Errorf("parsing provider file: %w", err)
+	}
+
+	list, err := constructorList(file, method)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(list.Elts))
+	for _, elt := range list.Elts {
+		ident, ok := elt.(*ast.Ident)
+		if !ok {
+			return nil, fmt.Errorf("unexpected element %T in the list returned by %s", elt, method)
+		}
+		if ident.Name == constructor {
+			return src, nil
+		}
+		names = append(names, ident.Name)
+	}
+
+	var offset int
+	i := sort.SearchStrings(names, constructor)
+	if !sort.StringsAreSorted(names) {
+		// Keep the existing order and append at the end
+		i = len(names)
+	}
+	if i < len(names) {
+		// Start of the line of the element sorting after the constructor, or of the comments
+		// above it
+		pos := list.Elts[i].Pos()
+		prev := list.Lbrace
+		if i > 0 {
+			prev = list.Elts[i-1].End()
+		}
+		for _, c := range file.Comments {
+			if c.Pos() > prev && c.End() < pos {
+				pos = c.Pos()
+				break
+			}
+		}
+		offset = fset.Position(pos).Offset
+		offset = bytes.LastIndexByte(src[:offset], '\n') + 1
+	} else {
+		// Start of the line of the closing brace
+		offset = fset.Position(list.Rbrace).Offset
+		offset = bytes.LastIndexByte(src[:offset], '\n') + 1
+	}
+
+	var buf bytes.Buffer
+	buf.Write(src[:offset])
+	buf.WriteString(constructor + ",\n")
+	buf.Write(src[offset:])
+
+	out, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("formatting provider file: %w", err)
+	}
+	return out, nil
+}
+
+// Constructors returns the constructors in the list returned by the given method of the provider in src.
+func Constructors(src []byte, method string) ([]string, error) {
+	file, err := parser.ParseFile(token.NewFileSet(), "", src, 0)
+	if err != nil {
+		return nil, fmt.Errorf("parsing provider file: %w", err)
+	}
+
+	list, err := constructorList(file, method)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(list.Elts))
+	for _, elt := range list.Elts {
+		if ident, ok := elt.(*ast.Ident); ok {
+			names = append(names, ident.Name)
+		}
+	}
+	return names, nil
+}
+
+// constructorList returns the composite literal returned by the given method.
+func constructorList(file *ast.File, method string) (*ast.CompositeLit, error) {
+	for _, decl := range file.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Recv == nil || fn.Name.Name != method || fn.Body == nil {
+			continue
+		}
+		for _, stmt := range fn.Body.List {
+			ret, ok := stmt.(*ast.ReturnStmt)
+			if !ok || len(ret.Results) != 1 {
+				continue
+			}
+			if list, ok := ret.Results[0].(*ast.CompositeLit); ok {
+				return list, nil
+			}
+		}
+		return nil, fmt.Errorf("method %s does not return a list literal", method)
+	}
+	return nil, fmt.Errorf("method %s not found", method)
+}
+
+// Diff returns a unified diff of the change from a to b, which must differ in a single block of
+// lines, as changed by Insert.
+func Diff(filename string, a, b []byte) string {
+	aLines := strings.SplitAfter(strings.TrimSuffix(string(a), "\n"), "\n")
+	bLines := strings.SplitAfter(strings.TrimSuffix(string(b), "\n"), "\n")
+
+	prefix := 0
+	for prefix < len(aLines) && prefix < len(bLines) && aLines[prefix] == bLines[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(aLines)-prefix && suffix < len(bLines)-prefix &&
+		aLines[len(aLines)-1-suffix] == bLines[len(bLines)-1-suffix] {
+		suffix++
+	}
+
+	const context = 3
+	start := prefix - context
+	if start < 0 {
+		start = 0
+	}
+	aEnd := len(aLines) - suffix + context
+	if aEnd > len(aLines) {
+		aEnd = len(aLines)
+	}
+	bEnd := len(bLines) - suffix + context
+	if bEnd > len(bLines) {
+		bEnd = len(bLines)
+	}
+
+	var buf strings.Builder
+	fmt.Fprintf(&buf, "--- a/%s\n+++ b/%s\n", filename, filename)
+	fmt.Fprintf(&buf, "@@ -%d,%d +%d,%d @@\n", start+1, aEnd-start, start+1, bEnd-start)
+	for _, l := range aLines[start:prefix] {
+		buf.WriteString(" " + l)
+	}
+	for _, l := range aLines[prefix : len(aLines)-suffix] {
+		buf.WriteString("-" + l)
+	}
+	for _, l := range bLines[prefix : len(bLines)-suffix] {
+		buf.WriteString("+" + l)
+	}
+	for _, l := range aLines[len(aLines)-suffix : aEnd] {
+		buf.WriteString(" " + l)
+	}
+	if !strings.HasSuffix(buf.String(), "\n") {
+		buf.WriteString("\n")
+	}
+	return buf.String()
+}
@@ -0,0 +1,131 @@
+package register
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const testProvider = `package atlassian
+
+func (*atlassianProvider) Resources(ctx context.Context) []func() resource.Resource {
+	return []func() resource.Resource{
+		NewJiraGroupResource,
+		// Issue types
+		NewJiraIssueTypeResource,
+	}
+}
+
+func (*atlassianProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
+	return []func() datasource.DataSource{
+		NewJiraGroupDataSource,
+	}
+}
+`
+
+func TestInsert(t *testing.T) {
+	tests := []struct {
+		TestName    string
+		Method      string
+		Constructor string
+		Expect      string
+	}{
+		{
+			TestName:    "first",
+			Method:      "Resources",
+			Constructor: "NewJiraAvatarResource",
+			Expect:      "{\n\t\tNewJiraAvatarResource,\n\t\tNewJiraGroupResource,\n",
+		},
+		{
+			TestName:    "middle",
+			Method:      "Resources",
+			Constructor: "NewJiraIssueResource",
+			Expect:      "\t\tNewJiraGroupResource,\n\t\tNewJiraIssueResource,\n\t\t// Issue types\n",
+		},
+		{
+			TestName:    "last",
+			Method:      "Resources",
+			Constructor: "NewJiraStatusResource",
+			Expect:      "\t\tNewJiraIssueTypeResource,\n\t\tNewJiraStatusResource,\n\t}\n",
+		},
+		{
+			TestName:    "data source",
+			Method:      "DataSources",
+			Constructor: "NewJiraStatusDataSource",
+			Expect:      "\t\tNewJiraGroupDataSource,\n\t\tNewJiraStatusDataSource,\n\t}\n",
+		},
+		{
+			TestName:    "already registered",
+			Method:      "Resources",
+			Constructor: "NewJiraGroupResource",
+			Expect:      testProvider,
+		},
+		{
+			TestName:    "unknown method",
+			Method:      "Functions",
+			Constructor: "NewJiraFooFunction",
+			Expect:      "method Functions not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.TestName, func(t *testing.T) {
+			out, err := Insert([]byte(testProvider), tt.Method, tt.Constructor)
+			result := string(out)
+			if err != nil {
+				result = err.Error()
+			}
+			if !strings.Contains(result, tt.Expect) {
+				t.Errorf("got %s, expected to contain %s", result, tt.Expect)
+			}
+			if err == nil && strings.Count(result, tt.Constructor) != 1 {
+				t.Errorf("got %s, expected %s once", result, tt.Constructor)
+			}
+		})
+	}
+}
+
+func TestConstructor_DryRun(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "provider.go")
+	if err := os.WriteFile(filename, []byte(testProvider), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	diff, err := Constructor(filename, "DataSources", "NewJiraStatusDataSource", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(diff, "+\t\tNewJiraStatusDataSource,\n") || !strings.Contains(diff, " \t\tNewJiraGroupDataSource,\n") {
+		t.Errorf("got diff %s", diff)
+	}
+
+	b, err := os.ReadFile(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(b) != testProvider {
+		t.Errorf("expected dry run to leave the file unchanged, got %s", b)
+	}
+
+	if _, err := Constructor(filename, "DataSources", "NewJiraStatusDataSource", false); err != nil {
+		t.Fatal(err)
+	}
+	b, err = os.ReadFile(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(b), "NewJiraStatusDataSource") {
+		t.Errorf("expected the constructor to be registered, got %s", b)
+	}
+}
+
+func TestConstructors(t *testing.T) {
+	names, err := Constructors([]byte(testProvider), "Resources")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := strings.Join(names, ","); got != "NewJiraGroupResource,NewJiraIssueTypeResource" {
+		t.Errorf("got constructors %s", got)
+	}
+}
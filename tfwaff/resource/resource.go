@@ -3,13 +3,15 @@ package resource
 import (
 	"bytes"
 	_ "embed"
-	"errors"
 	"fmt"
-	"io/fs"
-	"os"
+	"go/format"
+	"path/filepath"
 	"strings"
 	"text/template"
 
+	"github.com/openscientia/terraform-provider-atlassian/tfwaff/changelog"
+	"github.com/openscientia/terraform-provider-atlassian/tfwaff/openapi"
+	"github.com/openscientia/terraform-provider-atlassian/tfwaff/register"
 	"github.com/openscientia/terraform-provider-atlassian/tfwaff/utils"
 )
 
@@ -19,6 +21,18 @@ var resourceTmpl string
 //go:embed resource_test.tmpl
 var resourceTestTmpl string
 
+//go:embed resource_example.tmpl
+var resourceExampleTmpl string
+
+//go:embed resource_import.tmpl
+var resourceImportTmpl string
+
+//go:embed resource_import_block.tmpl
+var resourceImportBlockTmpl string
+
+//go:embed resource_docs.tmpl
+var resourceDocsTmpl string
+
 type resourceTemplateData struct {
 	ProviderLower          string
 	ProviderSuffix         string
@@ -34,9 +48,18 @@ type resourceTemplateData struct {
 	ResourceFilenamePrefix string
 	ServiceLower           string
 	ServiceTitle           string
+	// ServiceClient is the field of the provider holding the API client of the service.
+	ServiceClient string
+	// Schema holds the attributes generated from the OpenAPI spec, if any.
+	Schema *openapi.ResourceSchema
 }
 
-func Create(provider, name string, force, dry_run bool) error {
+// Create generates the files of a new resource: its Go and acceptance test files in the working
+// directory, its example configuration, import block and import script in examples/resources, and its docs
+// template in templates/resources, and adds it to CHANGELOG.md. When schema is not nil, the model
+// struct and schema attributes of the resource are generated from it. When registerConstructor is
+// set, the constructor of the resource is added to the provider in provider.go.
+func Create(provider, name string, schema *openapi.ResourceSchema, registerConstructor, force, dry_run bool) error {
 	if !utils.IsPascalCase(name) {
 		return fmt.Errorf("'name' must be in pascal case, e.g., FooBarBaz")
 	}
@@ -65,54 +88,110 @@ func Create(provider, name string, force, dry_run bool) error {
 		ResourceFilenamePrefix: "resource",
 		ServiceLower:           service,
 		ServiceTitle:           serviceTitle,
+		ServiceClient:          utils.GetServiceClient(service),
+		Schema:                 schema,
+	}
+
+	root, err := utils.RootDir()
+	if err != nil {
+		return err
+	}
+	exampleDir := filepath.Join(root, "examples", "resources", rtd.ResourceSnakeFull)
+
+	templates := []struct {
+		name, filename, tmpl string
+	}{
+		{"new-resource-file", fmt.Sprintf("%s_%s_%s.go", rtd.ResourceFilenamePrefix, rtd.ServiceLower, rtd.ResourceSnake), resourceTmpl},
+		{"new-resource-test-file", fmt.Sprintf("%s_%s_%s_test.go", rtd.ResourceFilenamePrefix, rtd.ServiceLower, rtd.ResourceSnake), resourceTestTmpl},
+		{"new-resource-example", filepath.Join(exampleDir, "basic.tf"), resourceExampleTmpl},
+		{"new-resource-import", filepath.Join(exampleDir, "import.sh"), resourceImportTmpl},
+		{"new-resource-import-block", filepath.Join(exampleDir, "import.tf"), resourceImportBlockTmpl},
+		{"new-resource-docs", filepath.Join(root, "templates", "resources", fmt.Sprintf("%s_%s.md.tmpl", rtd.ServiceLower, rtd.ResourceSnake)), resourceDocsTmpl},
 	}
 
-	if !dry_run {
-		filename := fmt.Sprintf("%s_%s_%s.go", rtd.ResourceFilenamePrefix, rtd.ServiceLower, rtd.ResourceSnake)
-		if err := writeTemplate("new-resource-file", filename, resourceTmpl, force, rtd); err != nil {
-			return fmt.Errorf("writing resource template: %w", err)
+	files := make([]utils.File, 0, len(templates))
+	for _, t := range templates {
+		src, err := renderTemplate(t.name, t.filename, t.tmpl, rtd)
+		if err != nil {
+			return fmt.Errorf("rendering %s: %w", t.filename, err)
 		}
+		files = append(files, utils.File{Name: t.filename, Src: src})
+	}
+
+	if err := utils.WriteFiles(files, force, dry_run); err != nil {
+		return fmt.Errorf("writing resource files: %w", err)
+	}
 
-		testFilename := fmt.Sprintf("%s_%s_%s_test.go", rtd.ResourceFilenamePrefix, rtd.ServiceLower, rtd.ResourceSnake)
-		if err := writeTemplate("new-resource-test-file", testFilename, resourceTestTmpl, force, rtd); err != nil {
-			return fmt.Errorf("writing resource test template: %w", err)
+	if registerConstructor {
+		if err := registerResource("New"+serviceTitle+rPascal+rtd.ResourceSuffix, dry_run); err != nil {
+			return err
 		}
 	}
 
+	if err := addChangelogEntry(filepath.Join(root, "CHANGELOG.md"), "* **New Resource:** `"+rtd.ResourceSnakeFull+"`", dry_run); err != nil {
+		return err
+	}
+
 	fmt.Println("created new resource:", name)
 
 	return nil
 }
 
-func writeTemplate(templateName, filename, tmpl string, force bool, td resourceTemplateData) error {
-	if _, err := os.Stat(filename); !errors.Is(err, fs.ErrNotExist) && !force {
-		return fmt.Errorf("file (%s) already exists and force is not set", filename)
-	}
-
-	f, err := os.OpenFile(filename, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0644)
-	if err != nil {
-		return fmt.Errorf("error opening file (%s): %s", filename, err)
-	}
-
+// renderTemplate executes the template of filename, and formats its output if it is a Go file.
+func renderTemplate(templateName, filename, tmpl string, td resourceTemplateData) ([]byte, error) {
 	tplate, err := template.New(templateName).Parse(tmpl)
 	if err != nil {
-		return fmt.Errorf("error parsing template: %s", err)
+		return nil, fmt.Errorf("error parsing template: %s", err)
 	}
 
 	var buffer bytes.Buffer
 	err = tplate.Execute(&buffer, td)
 	if err != nil {
-		return fmt.Errorf("error executing template: %s", err)
+		return nil, fmt.Errorf("error executing template: %s", err)
 	}
 
-	if _, err := f.Write(buffer.Bytes()); err != nil {
-		f.Close() // ignore error; Write error takes precedence
-		return fmt.Errorf("error writing to file (%s): %s", filename, err)
+	if filepath.Ext(filename) != ".go" {
+		return buffer.Bytes(), nil
 	}
 
-	if err := f.Close(); err != nil {
-		return fmt.Errorf("error closing file (%s): %s", filename, err)
+	src, err := format.Source(buffer.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("error formatting template output: %s", err)
 	}
 
+	return src, nil
+}
+
+// registerResource adds the constructor to the Resources of the provider, or prints the change in a dry run.
+func registerResource(constructor string, dry_run bool) error {
+	diff, err := register.Constructor("provider.go", "Resources", constructor, dry_run)
+	if err != nil {
+		return fmt.Errorf("registering resource: %w", err)
+	}
+	switch {
+	case diff == "":
+		fmt.Println("already registered:", constructor)
+	case dry_run:
+		fmt.Print(diff)
+	default:
+		fmt.Println("registered in provider.go:", constructor)
+	}
+	return nil
+}
+
+// addChangelogEntry adds the entry to the unreleased version in CHANGELOG.md, or prints the change in a dry run.
+func addChangelogEntry(filename, entry string, dry_run bool) error {
+	diff, err := changelog.Entry(filename, entry, dry_run)
+	if err != nil {
+		return fmt.Errorf("adding changelog entry: %w", err)
+	}
+	switch {
+	case diff == "":
+		fmt.Println("already in CHANGELOG.md:", entry)
+	case dry_run:
+		fmt.Print(diff)
+	default:
+		fmt.Println("added to CHANGELOG.md:", entry)
+	}
 	return nil
 }
@@ -1,7 +1,10 @@
 package resource
 
 import (
+	"strings"
 	"testing"
+
+	"github.com/openscientia/terraform-provider-atlassian/tfwaff/openapi"
 )
 
 func TestCreate(t *testing.T) {
@@ -29,7 +32,7 @@ func TestCreate(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.TestName, func(t *testing.T) {
-			result := Create("abc", tt.Input, true, true)
+			result := Create("abc", tt.Input, nil, false, true, true)
 			if result != nil {
 				if result.Error() != tt.Expect {
 					t.Errorf("got %s, expected %s", result.Error(), tt.Expect)
@@ -38,3 +41,104 @@ func TestCreate(t *testing.T) {
 		})
 	}
 }
+
+func TestRenderTemplate_OpenAPI(t *testing.T) {
+	s, err := openapi.Load("../openapi/testdata/openapi3.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	schema, err := s.ResourceSchema("createIssueType")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	src, err := renderTemplate("new-resource-file", "resource_jira_foo_bar.go", resourceTmpl, resourceTemplateData{
+		ProviderLower:       "atlassian",
+		ProviderSuffix:      "Provider",
+		ResourcePascal:      "FooBar",
+		ResourceSuffix:      "Resource",
+		ResourceModelSuffix: "ResourceModel",
+		ServiceLower:        "jira",
+		Schema:              schema,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, expect := range []string{
+		`HierarchyLevel types.Int64  ` + "`" + `tfsdk:"hierarchy_level"` + "`",
+		`"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"`,
+		`stringvalidator.OneOf("subtask", "standard"),`,
+		`// TODO: add the attribute of the property scope (object), which has no matching attribute type`,
+	} {
+		if !strings.Contains(string(src), expect) {
+			t.Errorf("expected generated resource to contain %s", expect)
+		}
+	}
+}
+
+func TestRenderTemplate_Files(t *testing.T) {
+	s, err := openapi.Load("../openapi/testdata/swagger2.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	schema, err := s.ResourceSchema("/wiki/rest/api/space")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	td := resourceTemplateData{
+		ResourceSnakeFull: "atlassian_jira_foo_bar",
+		ResourceTitle:     "Foo Bar",
+		ServiceTitle:      "Jira",
+	}
+	tdSchema := td
+	tdSchema.Schema = schema
+
+	tests := []struct {
+		TestName string
+		Input    string
+		Expect   string
+	}{
+		{
+			TestName: "example",
+			Input:    resourceExampleTmpl,
+			Expect:   "resource \"atlassian_jira_foo_bar\" \"example\" {\n  name = \"foo\"\n}\n",
+		},
+		{
+			TestName: "import",
+			Input:    resourceImportTmpl,
+			Expect:   "terraform import atlassian_jira_foo_bar.example 10000\n",
+		},
+		{
+			TestName: "import block",
+			Input:    resourceImportBlockTmpl,
+			Expect:   "import {\n  to = atlassian_jira_foo_bar.example\n  id = \"10000\"\n}\n",
+		},
+		{
+			TestName: "docs",
+			Input:    resourceDocsTmpl,
+			Expect:   "{{ .Name | printf \"examples/resources/%s/import.sh\" | codefile \"shell\" }}\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.TestName, func(t *testing.T) {
+			src, err := renderTemplate(tt.TestName, "basic.tf", tt.Input, td)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !strings.HasSuffix(string(src), tt.Expect) {
+				t.Errorf("got %s, expected suffix %s", src, tt.Expect)
+			}
+		})
+	}
+
+	src, err := renderTemplate("example", "basic.tf", resourceExampleTmpl, tdSchema)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expect := "resource \"atlassian_jira_foo_bar\" \"example\" {\n  key  = \"foo\"\n  name = \"foo\"\n}\n"; string(src) != expect {
+		t.Errorf("got %s, expected %s", src, expect)
+	}
+}
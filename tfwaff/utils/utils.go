@@ -1,6 +1,11 @@
 package utils
 
 import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
 	"regexp"
 	"strings"
 
@@ -33,3 +38,99 @@ func GetTitleCase(input string) string {
 	}
 	return cases.Title(language.Und, cases.NoLower).String(lower)
 }
+
+// GetSnakeCaseFromCamelCase converts the camel case names of the API, e.g. defaultIssueTypeId or
+// iconURL, to snake case.
+func GetSnakeCaseFromCamelCase(input string) string {
+	snake := regexp.MustCompile(`([A-Z]+)([A-Z][a-z])`).ReplaceAllString(input, "${1}_${2}")
+	snake = regexp.MustCompile(`([a-z0-9])([A-Z])`).ReplaceAllString(snake, "${1}_${2}")
+	return strings.ToLower(snake)
+}
+
+// GetPascalCaseFromSnakeCase converts a snake case name to the name of a Go field, e.g. issue_type_id
+// to IssueTypeId. The name id is converted to ID.
+func GetPascalCaseFromSnakeCase(input string) string {
+	if input == "id" {
+		return "ID"
+	}
+	var pascal strings.Builder
+	for _, word := range strings.Split(input, "_") {
+		if word == "" {
+			continue
+		}
+		pascal.WriteString(strings.ToUpper(word[:1]) + word[1:])
+	}
+	return pascal.String()
+}
+
+// serviceClients are the fields of the provider holding the API client of each service.
+var serviceClients = map[string]string{
+	"agile":       "agile",
+	"confluence":  "confluence",
+	"jira":        "jira",
+	"servicedesk": "serviceManagement",
+}
+
+// GetServiceClient returns the field of the provider holding the API client of the service, i.e.
+// the first word of the name of a resource or data source, e.g. serviceManagement for servicedesk.
+// Other services use the Jira client.
+func GetServiceClient(service string) string {
+	if client, ok := serviceClients[service]; ok {
+		return client
+	}
+	return "jira"
+}
+
+// rootMarker is a file at the root of the provider repository.
+const rootMarker = "terraform-registry-manifest.json"
+
+// RootDir returns the root directory of the provider repository, i.e. the first parent of the
+// working directory containing terraform-registry-manifest.json, relative to the working directory.
+func RootDir() (string, error) {
+	wd, err := os.Getwd()
+	if err != nil {
+		return "", err
+	}
+	for dir := wd; ; dir = filepath.Dir(dir) {
+		if _, err := os.Stat(filepath.Join(dir, rootMarker)); err == nil {
+			return filepath.Rel(wd, dir)
+		}
+		if dir == filepath.Dir(dir) {
+			return "", fmt.Errorf("%s not found in any parent directory, run from the provider repository", rootMarker)
+		}
+	}
+}
+
+// File is a generated file.
+type File struct {
+	Name string
+	Src  []byte
+}
+
+// WriteFiles writes the files, creating their directories if needed. Existing files are only
+// overwritten when force is set, otherwise no file is written. When dryRun is set, the files are
+// listed instead of written.
+func WriteFiles(files []File, force, dryRun bool) error {
+	if !force {
+		for _, f := range files {
+			if _, err := os.Stat(f.Name); !errors.Is(err, fs.ErrNotExist) {
+				return fmt.Errorf("file (%s) already exists and force is not set", f.Name)
+			}
+		}
+	}
+
+	for _, f := range files {
+		if dryRun {
+			fmt.Println("would write:", f.Name)
+			continue
+		}
+		if err := os.MkdirAll(filepath.Dir(f.Name), 0755); err != nil {
+			return fmt.Errorf("error creating directory of file (%s): %s", f.Name, err)
+		}
+		if err := os.WriteFile(f.Name, f.Src, 0644); err != nil {
+			return fmt.Errorf("error writing to file (%s): %s", f.Name, err)
+		}
+	}
+
+	return nil
+}
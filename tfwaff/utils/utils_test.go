@@ -1,6 +1,11 @@
 package utils
 
 import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
 	"strconv"
 	"testing"
 )
@@ -123,3 +128,171 @@ func TestGetTitleCase(t *testing.T) {
 		})
 	}
 }
+
+func TestGetSnakeCaseFromCamelCase(t *testing.T) {
+	tests := []struct {
+		TestName string
+		Input    string
+		Expect   string
+	}{
+		{
+			TestName: "single word",
+			Input:    "self",
+			Expect:   "self",
+		},
+		{
+			TestName: "camel case",
+			Input:    "defaultIssueTypeId",
+			Expect:   "default_issue_type_id",
+		},
+		{
+			TestName: "acronym",
+			Input:    "iconURL",
+			Expect:   "icon_url",
+		},
+		{
+			TestName: "acronym in the middle",
+			Input:    "avatarURLSize",
+			Expect:   "avatar_url_size",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.TestName, func(t *testing.T) {
+			result := GetSnakeCaseFromCamelCase(tt.Input)
+			if result != tt.Expect {
+				t.Errorf("got %s, expected %s", result, tt.Expect)
+			}
+		})
+	}
+}
+
+func TestGetPascalCaseFromSnakeCase(t *testing.T) {
+	tests := []struct {
+		TestName string
+		Input    string
+		Expect   string
+	}{
+		{
+			TestName: "id",
+			Input:    "id",
+			Expect:   "ID",
+		},
+		{
+			TestName: "snake case",
+			Input:    "default_issue_type_id",
+			Expect:   "DefaultIssueTypeId",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.TestName, func(t *testing.T) {
+			result := GetPascalCaseFromSnakeCase(tt.Input)
+			if result != tt.Expect {
+				t.Errorf("got %s, expected %s", result, tt.Expect)
+			}
+		})
+	}
+}
+
+func TestGetServiceClient(t *testing.T) {
+	tests := []struct {
+		TestName string
+		Input    string
+		Expect   string
+	}{
+		{
+			TestName: "jira",
+			Input:    "jira",
+			Expect:   "jira",
+		},
+		{
+			TestName: "confluence",
+			Input:    "confluence",
+			Expect:   "confluence",
+		},
+		{
+			TestName: "service management",
+			Input:    "servicedesk",
+			Expect:   "serviceManagement",
+		},
+		{
+			TestName: "unknown service",
+			Input:    "foo",
+			Expect:   "jira",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.TestName, func(t *testing.T) {
+			result := GetServiceClient(tt.Input)
+			if result != tt.Expect {
+				t.Errorf("got %s, expected %s", result, tt.Expect)
+			}
+		})
+	}
+}
+
+func TestRootDir(t *testing.T) {
+	result, err := RootDir()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expect := filepath.Join("..", ".."); result != expect {
+		t.Errorf("got %s, expected %s", result, expect)
+	}
+}
+
+func TestWriteFiles(t *testing.T) {
+	dir := t.TempDir()
+	example := filepath.Join(dir, "examples", "basic.tf")
+	docs := filepath.Join(dir, "templates", "foo.md.tmpl")
+
+	if err := WriteFiles([]File{{Name: example, Src: []byte("a")}}, false, true); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(example); !errors.Is(err, fs.ErrNotExist) {
+		t.Fatalf("expected no file written in a dry run, got %v", err)
+	}
+
+	if err := WriteFiles([]File{{Name: example, Src: []byte("a")}}, false, false); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		TestName string
+		Force    bool
+		Expect   string
+	}{
+		{
+			TestName: "existing file",
+			Force:    false,
+			Expect:   fmt.Sprintf("file (%s) already exists and force is not set", example),
+		},
+		{
+			TestName: "existing file with force",
+			Force:    true,
+			Expect:   "b b",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.TestName, func(t *testing.T) {
+			files := []File{{Name: docs, Src: []byte("b")}, {Name: example, Src: []byte("b")}}
+			result := ""
+			if err := WriteFiles(files, tt.Force, false); err != nil {
+				result = err.Error()
+				if _, err := os.Stat(docs); !errors.Is(err, fs.ErrNotExist) {
+					t.Errorf("expected no file written, got %v", err)
+				}
+			} else {
+				a, _ := os.ReadFile(docs)
+				b, _ := os.ReadFile(example)
+				result = string(a) + " " + string(b)
+			}
+			if result != tt.Expect {
+				t.Errorf("got %s, expected %s", result, tt.Expect)
+			}
+		})
+	}
+}